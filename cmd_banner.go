@@ -0,0 +1,240 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Structure for our options and state.
+type bannerCommand struct {
+
+	// The name of the font to use.
+	font string
+
+	// The width at which to wrap the output.
+	width int
+}
+
+// bannerFont holds the glyphs of a font, each of which has a line of
+// text for each row of the font.
+type bannerFont struct {
+
+	// The number of rows in each glyph.
+	height int
+
+	// The width of a space, which is used for characters the font lacks.
+	space int
+
+	// The glyphs, by character.
+	glyphs map[rune][]string
+}
+
+// bannerFonts are the fonts we can use.
+var bannerFonts = map[string]bannerFont{
+	"block": {
+		height: 5,
+		space:  3,
+		glyphs: map[rune][]string{
+			'A': {" ### ", "#   #", "#####", "#   #", "#   #"},
+			'B': {"#### ", "#   #", "#### ", "#   #", "#### "},
+			'C': {" ####", "#    ", "#    ", "#    ", " ####"},
+			'D': {"#### ", "#   #", "#   #", "#   #", "#### "},
+			'E': {"#####", "#    ", "#### ", "#    ", "#####"},
+			'F': {"#####", "#    ", "#### ", "#    ", "#    "},
+			'G': {" ####", "#    ", "#  ##", "#   #", " ### "},
+			'H': {"#   #", "#   #", "#####", "#   #", "#   #"},
+			'I': {"#####", "  #  ", "  #  ", "  #  ", "#####"},
+			'J': {"#####", "   # ", "   # ", "#  # ", " ##  "},
+			'K': {"#   #", "#  # ", "###  ", "#  # ", "#   #"},
+			'L': {"#    ", "#    ", "#    ", "#    ", "#####"},
+			'M': {"#   #", "## ##", "# # #", "#   #", "#   #"},
+			'N': {"#   #", "##  #", "# # #", "#  ##", "#   #"},
+			'O': {" ### ", "#   #", "#   #", "#   #", " ### "},
+			'P': {"#### ", "#   #", "#### ", "#    ", "#    "},
+			'Q': {" ### ", "#   #", "# # #", "#  # ", " ## #"},
+			'R': {"#### ", "#   #", "#### ", "#  # ", "#   #"},
+			'S': {" ####", "#    ", " ### ", "    #", "#### "},
+			'T': {"#####", "  #  ", "  #  ", "  #  ", "  #  "},
+			'U': {"#   #", "#   #", "#   #", "#   #", " ### "},
+			'V': {"#   #", "#   #", "#   #", " # # ", "  #  "},
+			'W': {"#   #", "#   #", "# # #", "## ##", "#   #"},
+			'X': {"#   #", " # # ", "  #  ", " # # ", "#   #"},
+			'Y': {"#   #", " # # ", "  #  ", "  #  ", "  #  "},
+			'Z': {"#####", "   # ", "  #  ", " #   ", "#####"},
+			'0': {" ### ", "#  ##", "# # #", "##  #", " ### "},
+			'1': {"  #  ", " ##  ", "  #  ", "  #  ", " ### "},
+			'2': {" ### ", "#   #", "  ## ", " #   ", "#####"},
+			'3': {"#### ", "    #", " ### ", "    #", "#### "},
+			'4': {"#   #", "#   #", "#####", "    #", "    #"},
+			'5': {"#####", "#    ", "#### ", "    #", "#### "},
+			'6': {" ### ", "#    ", "#### ", "#   #", " ### "},
+			'7': {"#####", "    #", "   # ", "  #  ", "  #  "},
+			'8': {" ### ", "#   #", " ### ", "#   #", " ### "},
+			'9': {" ### ", "#   #", " ####", "    #", " ### "},
+		},
+	},
+	"small": {
+		height: 3,
+		space:  2,
+		glyphs: map[rune][]string{
+			'A': {"┌─┐", "├─┤", "┴ ┴"},
+			'B': {"┌┐ ", "├┴┐", "└─┘"},
+			'C': {"┌─┐", "│  ", "└─┘"},
+			'D': {"┌┬┐", " ││", "─┴┘"},
+			'E': {"┌─┐", "├┤ ", "└─┘"},
+			'F': {"┌─┐", "├┤ ", "└  "},
+			'G': {"┌─┐", "│ ┬", "└─┘"},
+			'H': {"┬ ┬", "├─┤", "┴ ┴"},
+			'I': {"┬", "│", "┴"},
+			'J': {" ┬", " │", "└┘"},
+			'K': {"┬┌─", "├┴┐", "┴ ┴"},
+			'L': {"┬  ", "│  ", "┴─┘"},
+			'M': {"┌┬┐", "│││", "┴ ┴"},
+			'N': {"┌┐┌", "│││", "┘└┘"},
+			'O': {"┌─┐", "│ │", "└─┘"},
+			'P': {"┌─┐", "├─┘", "┴  "},
+			'Q': {"┌─┐ ", "│─┼┐", "└─┘└"},
+			'R': {"┬─┐", "├┬┘", "┴└─"},
+			'S': {"┌─┐", "└─┐", "└─┘"},
+			'T': {"┌┬┐", " │ ", " ┴ "},
+			'U': {"┬ ┬", "│ │", "└─┘"},
+			'V': {"┬  ┬", "└┐┌┘", " └┘ "},
+			'W': {"┬ ┬", "│││", "└┴┘"},
+			'X': {"─┐ ┬", "┌┴┬┘", "┴ └─"},
+			'Y': {"┬ ┬", "└┬┘", " ┴ "},
+			'Z': {"┌─┐", "┌─┘", "└─┘"},
+			'0': {"┌─┐", "│/│", "└─┘"},
+			'1': {"┐", "│", "┴"},
+			'2': {"┌─┐", "┌─┘", "└──"},
+			'3': {"┌─┐", " ─┤", "└─┘"},
+			'4': {"┬ ┬", "└─┤", "  ┴"},
+			'5': {"┌──", "└─┐", "└─┘"},
+			'6': {"┌─┐", "├─┐", "└─┘"},
+			'7': {"┌─┐", "  │", "  ┴"},
+			'8': {"┌─┐", "├─┤", "└─┘"},
+			'9': {"┌─┐", "└─┤", "└─┘"},
+		},
+	},
+}
+
+// Arguments adds per-command args to the object.
+func (b *bannerCommand) Arguments(f *flag.FlagSet) {
+	f.StringVar(&b.font, "font", "block", "The font to use, one of "+strings.Join(b.fonts(), ", "))
+	f.IntVar(&b.width, "width", 80, "The width at which to wrap the output")
+}
+
+// Info returns the name of this subcommand.
+func (b *bannerCommand) Info() (string, string) {
+	return "banner", `Show text as large ASCII-art letters.
+
+Details:
+
+This command shows the given text in large letters, built from smaller
+characters, which is useful for making headers stand out in long logs.
+The 'small' font uses Unicode box-drawing characters, rather than ASCII.
+
+Letters and digits are shown, with lower-case letters shown as upper-case,
+and anything else being shown as a blank.  The text is wrapped between
+words at 80 columns, or the width given via '-width'.
+
+The '-font' flag selects the font to use, one of:
+
+   ` + strings.Join(b.fonts(), ", ") + `
+
+Examples:
+
+$ sysbox banner Deploying
+$ sysbox banner -font small -width 60 build 1234 complete`
+}
+
+// fonts returns the names of the fonts we can use, sorted.
+func (b *bannerCommand) fonts() []string {
+
+	var names []string
+	for name := range bannerFonts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// glyph returns the glyph for the given character, which is blank if the
+// font lacks it.
+func (b *bannerCommand) glyph(font bannerFont, c rune) []string {
+
+	if glyph, ok := font.glyphs[unicode.ToUpper(c)]; ok {
+		return glyph
+	}
+
+	blank := make([]string, font.height)
+	for i := range blank {
+		blank[i] = strings.Repeat(" ", font.space)
+	}
+	return blank
+}
+
+// render returns the rows of the given text, with a column between each
+// character.
+func (b *bannerCommand) render(font bannerFont, text string) []string {
+
+	rows := make([]string, font.height)
+	for i, c := range text {
+		glyph := b.glyph(font, c)
+		for r := range rows {
+			if i > 0 {
+				rows[r] += " "
+			}
+			rows[r] += glyph[r]
+		}
+	}
+	return rows
+}
+
+// Execute is invoked if the user specifies `banner` as the subcommand.
+func (b *bannerCommand) Execute(args []string) int {
+
+	font, ok := bannerFonts[b.font]
+	if !ok {
+		fmt.Printf("error: unknown font '%s', choose one of %s\n", b.font, strings.Join(b.fonts(), ", "))
+		return 1
+	}
+	if len(args) < 1 {
+		fmt.Printf("Usage: banner [-font name] [-width N] text\n")
+		return 1
+	}
+
+	width := func(text string) int {
+		return utf8.RuneCountInString(b.render(font, text)[0])
+	}
+
+	// Wrap the words into lines which fit within our width.  Words
+	// which are too wide by themselves are shown on their own line.
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(strings.Join(args, " ")) {
+		if line != "" && width(line+" "+word) > b.width {
+			lines = append(lines, line)
+			line = word
+		} else if line != "" {
+			line += " " + word
+		} else {
+			line = word
+		}
+	}
+	lines = append(lines, line)
+
+	for i, line := range lines {
+		if i > 0 {
+			fmt.Println()
+		}
+		for _, row := range b.render(font, line) {
+			fmt.Println(strings.TrimRight(row, " "))
+		}
+	}
+
+	return 0
+}
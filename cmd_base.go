@@ -0,0 +1,145 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Structure for our options and state.
+type baseCommand struct {
+
+	// The base of the input, or zero to detect it.
+	from int
+
+	// The base of the output.
+	to int
+
+	// Show all the common representations?
+	all bool
+}
+
+// Arguments adds per-command args to the object.
+func (b *baseCommand) Arguments(f *flag.FlagSet) {
+	f.IntVar(&b.from, "from", 0, "The base of the input, between 2 and 36, detected from any 0x, 0o, or 0b prefix if unset")
+	f.IntVar(&b.to, "to", 10, "The base of the output, between 2 and 36")
+	f.BoolVar(&b.all, "all", false, "Show the number in binary, octal, decimal, and hexadecimal")
+}
+
+// Info returns the name of this subcommand.
+func (b *baseCommand) Info() (string, string) {
+	return "base", `Convert numbers between bases.
+
+Details:
+
+This command converts integers from one base to another, with bases
+between 2 and 36 being supported.  Numbers of any size may be used.
+
+By default the base of the input is detected from its prefix, with '0x'
+meaning hexadecimal, '0o' octal, and '0b' binary, and anything else
+being decimal.  The '-from' flag may be used to specify it instead.
+
+The output is in decimal, unless '-to' is given, or '-all' shows the
+number in binary, octal, decimal, and hexadecimal at once.
+
+Examples:
+
+$ sysbox base -from 16 -to 2 ff
+$ sysbox base -to 16 65535
+$ sysbox base -all 0b101010`
+}
+
+// parse parses the given number, in the given base, or detecting the
+// base from its prefix if the base is zero.
+func (b *baseCommand) parse(text string, base int) (*big.Int, error) {
+
+	// Handle the prefixes ourselves, so that they're accepted even when
+	// the base is given.
+	number := strings.ToLower(text)
+	negative := strings.HasPrefix(number, "-")
+	number = strings.TrimPrefix(strings.TrimPrefix(number, "-"), "+")
+
+	prefixes := map[string]int{"0x": 16, "0o": 8, "0b": 2}
+	for prefix, value := range prefixes {
+		if strings.HasPrefix(number, prefix) && (base == 0 || base == value) {
+			number = number[len(prefix):]
+			base = value
+			break
+		}
+	}
+	if base == 0 {
+		base = 10
+	}
+	if negative {
+		number = "-" + number
+	}
+
+	if value, err := strconv.ParseInt(number, base, 64); err == nil {
+		return big.NewInt(value), nil
+	}
+
+	// Fall back to math/big for values which don't fit in an int64.
+	value, ok := new(big.Int).SetString(number, base)
+	if !ok {
+		return nil, fmt.Errorf("'%s' is not a valid base-%d number", text, base)
+	}
+	return value, nil
+}
+
+// format returns the number in the given base.
+func (b *baseCommand) format(value *big.Int, base int) string {
+
+	if value.IsInt64() {
+		return strconv.FormatInt(value.Int64(), base)
+	}
+	return value.Text(base)
+}
+
+// Execute is invoked if the user specifies `base` as the subcommand.
+func (b *baseCommand) Execute(args []string) int {
+
+	if len(args) < 1 {
+		fmt.Printf("Usage: base [-from N] [-to N] [-all] number [number ...]\n")
+		return 1
+	}
+	if b.from != 0 && (b.from < 2 || b.from > 36) {
+		fmt.Printf("error: -from must be between 2 and 36\n")
+		return 1
+	}
+	if b.to < 2 || b.to > 36 {
+		fmt.Printf("error: -to must be between 2 and 36\n")
+		return 1
+	}
+
+	for i, arg := range args {
+		value, err := b.parse(arg, b.from)
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			return 1
+		}
+
+		if !b.all {
+			fmt.Println(b.format(value, b.to))
+			continue
+		}
+
+		if i > 0 {
+			fmt.Println()
+		}
+
+		sign := ""
+		if value.Sign() < 0 {
+			sign = "-"
+		}
+		abs := new(big.Int).Abs(value)
+
+		fmt.Printf("bin: %s0b%s\n", sign, b.format(abs, 2))
+		fmt.Printf("oct: %s0o%s\n", sign, b.format(abs, 8))
+		fmt.Printf("dec: %s\n", b.format(value, 10))
+		fmt.Printf("hex: %s0x%s\n", sign, b.format(abs, 16))
+	}
+
+	return 0
+}
@@ -0,0 +1,244 @@
+package main
+
+import (
+	"bufio"
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Structure for our options and state.
+type base64Command struct {
+
+	// Decode, rather than encode?
+	decode bool
+
+	// Use the URL-safe alphabet?
+	url bool
+
+	// Omit padding?
+	raw bool
+
+	// Wrap encoded output at this many columns, zero to disable.
+	wrap int
+}
+
+// Arguments adds per-command args to the object.
+func (b *base64Command) Arguments(f *flag.FlagSet) {
+	f.BoolVar(&b.decode, "d", false, "Decode the input, rather than encoding it")
+	f.BoolVar(&b.url, "url", false, "Use the URL-safe alphabet")
+	f.BoolVar(&b.raw, "raw", false, "Omit padding when encoding, and don't expect it when decoding")
+	f.IntVar(&b.wrap, "wrap", 76, "Wrap encoded lines after this many characters, 0 to disable")
+}
+
+// Info returns the name of this subcommand.
+func (b *base64Command) Info() (string, string) {
+	return "base64", `Encode, or decode, base64 data.
+
+Details:
+
+This command reads the named file, or STDIN if no file is given, and
+writes the base64-encoded version of it to STDOUT.  Encoded output is
+wrapped at 76 characters, which may be changed via '-wrap', with '-wrap 0'
+disabling wrapping entirely.
+
+The '-d' flag will decode the input instead, ignoring any newlines which
+are present.  If the input is malformed the offset of the first invalid
+byte is reported.
+
+The '-url' flag will use the URL-safe alphabet, and '-raw' will omit the
+trailing padding.
+
+Examples:
+
+$ echo -n 'Hello, World' | sysbox base64
+SGVsbG8sIFdvcmxk
+$ echo SGVsbG8sIFdvcmxk | sysbox base64 -d
+Hello, World`
+}
+
+// encoding returns the encoding the user selected.
+func (b *base64Command) encoding() *base64.Encoding {
+	enc := base64.StdEncoding
+	if b.url {
+		enc = base64.URLEncoding
+	}
+	if b.raw {
+		enc = enc.WithPadding(base64.NoPadding)
+	}
+	return enc
+}
+
+// lineWrapper is a writer which inserts a newline after every width bytes
+// written through it.
+type lineWrapper struct {
+	w     io.Writer
+	width int
+	col   int
+}
+
+// Write writes the given bytes, inserting newlines as necessary.
+func (l *lineWrapper) Write(p []byte) (int, error) {
+
+	written := 0
+	for len(p) > 0 {
+
+		n := len(p)
+		if l.width > 0 && n > l.width-l.col {
+			n = l.width - l.col
+		}
+
+		m, err := l.w.Write(p[:n])
+		written += m
+		if err != nil {
+			return written, err
+		}
+
+		l.col += n
+		p = p[n:]
+
+		if l.width > 0 && l.col == l.width {
+			if _, err := l.w.Write([]byte("\n")); err != nil {
+				return written, err
+			}
+			l.col = 0
+		}
+	}
+
+	return written, nil
+}
+
+// encodeStream writes the base64-encoded form of the input to the output.
+func (b *base64Command) encodeStream(in io.Reader, out io.Writer) error {
+
+	wrapper := &lineWrapper{w: out, width: b.wrap}
+	encoder := base64.NewEncoder(b.encoding(), wrapper)
+
+	if _, err := io.Copy(encoder, in); err != nil {
+		return err
+	}
+	if err := encoder.Close(); err != nil {
+		return err
+	}
+
+	// Terminate the final line.
+	if wrapper.col > 0 {
+		_, err := out.Write([]byte("\n"))
+		return err
+	}
+	return nil
+}
+
+// decodeStream writes the decoded form of the base64 input to the output.
+//
+// The input is decoded in blocks, skipping whitespace, while remembering
+// the offset of each byte so that errors may be reported accurately.
+func (b *base64Command) decodeStream(in io.Reader, out io.Writer) error {
+
+	const blockSize = 4 * 1024
+
+	enc := b.encoding()
+	reader := bufio.NewReader(in)
+
+	block := make([]byte, 0, blockSize)
+	offsets := make([]int64, 0, blockSize)
+	decoded := make([]byte, enc.DecodedLen(blockSize))
+
+	offset := int64(-1)
+	padded := false
+
+	// flush decodes the block we've collected.
+	flush := func() error {
+		if len(block) == 0 {
+			return nil
+		}
+		if padded {
+			return fmt.Errorf("illegal base64 data at input byte %d", offsets[0])
+		}
+
+		n, err := enc.Decode(decoded, block)
+		if err != nil {
+			if corrupt, ok := err.(base64.CorruptInputError); ok && int(corrupt) < len(offsets) {
+				return fmt.Errorf("illegal base64 data at input byte %d", offsets[corrupt])
+			}
+			return err
+		}
+
+		padded = block[len(block)-1] == '='
+		block = block[:0]
+		offsets = offsets[:0]
+
+		_, err = out.Write(decoded[:n])
+		return err
+	}
+
+	for {
+		c, err := reader.ReadByte()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		offset++
+
+		switch c {
+		case '\n', '\r', ' ', '\t':
+			continue
+		}
+
+		block = append(block, c)
+		offsets = append(offsets, offset)
+
+		if len(block) == blockSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return flush()
+}
+
+// Execute is invoked if the user specifies `base64` as the subcommand.
+func (b *base64Command) Execute(args []string) int {
+
+	if len(args) > 1 {
+		fmt.Printf("Usage: base64 [-d] [file]\n")
+		return 1
+	}
+
+	// Read from STDIN by default, or the named file.
+	var in io.Reader = os.Stdin
+	if len(args) == 1 && args[0] != "-" {
+		file, err := os.Open(args[0])
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			return 1
+		}
+		defer file.Close()
+		in = file
+	}
+
+	out := bufio.NewWriter(os.Stdout)
+
+	var err error
+	if b.decode {
+		err = b.decodeStream(in, out)
+	} else {
+		err = b.encodeStream(in, out)
+	}
+
+	// Flush whatever we managed to process, even on error.
+	if ferr := out.Flush(); err == nil {
+		err = ferr
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+)
+
+// Structure for our options and state.
+type bytesCommand struct {
+
+	// Parse human-readable sizes, rather than formatting them?
+	parse bool
+
+	// Use SI units, rather than binary units?
+	si bool
+}
+
+// bytesBareSuffix matches sizes with a single-letter suffix, such as "2G".
+var bytesBareSuffix = regexp.MustCompile(`(?i)^([0-9.]+)\s*([kmgtpe])$`)
+
+// Arguments adds per-command args to the object.
+func (b *bytesCommand) Arguments(f *flag.FlagSet) {
+	f.BoolVar(&b.parse, "parse", false, "Parse human-readable sizes, such as '2G', into bytes")
+	f.BoolVar(&b.si, "si", false, "Use SI units, powers of 1000, rather than binary units, powers of 1024")
+}
+
+// Info returns the name of this subcommand.
+func (b *bytesCommand) Info() (string, string) {
+	return "bytes", `Convert byte counts to, and from, human-readable sizes.
+
+Details:
+
+This command converts the given byte counts into human-readable sizes,
+such as '1.5 KiB', or with '-parse' converts sizes back into bytes.  If
+no values are given they are read from STDIN, one per line.
+
+By default binary units are used, powers of 1024 such as KiB and MiB,
+the '-si' flag uses SI units instead, powers of 1000 such as kB and MB.
+
+When parsing, sizes with explicit units such as '2GB' or '2GiB' are
+always parsed according to their units, while those with a single
+letter, such as '2G', use binary units unless '-si' is given.
+
+Examples:
+
+$ sysbox bytes 1536
+1.5 KiB
+$ sysbox bytes -si 1536
+1.5 kB
+$ sysbox bytes -parse 2G
+2147483648
+$ sysbox du | sysbox cut -f 1 | sysbox bytes`
+}
+
+// convert converts a single value.
+func (b *bytesCommand) convert(value string) (string, error) {
+
+	value = strings.TrimSpace(value)
+
+	if b.parse {
+		if m := bytesBareSuffix.FindStringSubmatch(value); m != nil && !b.si {
+			value = m[1] + m[2] + "i"
+		}
+		n, err := humanize.ParseBytes(value)
+		if err != nil {
+			return "", fmt.Errorf("invalid size '%s'", value)
+		}
+		return strconv.FormatUint(n, 10), nil
+	}
+
+	n, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid byte count '%s'", value)
+	}
+	if b.si {
+		return humanize.Bytes(n), nil
+	}
+	return humanize.IBytes(n), nil
+}
+
+// Execute is invoked if the user specifies `bytes` as the subcommand.
+func (b *bytesCommand) Execute(args []string) int {
+
+	if len(args) > 0 {
+		for _, arg := range args {
+			out, err := b.convert(arg)
+			if err != nil {
+				fmt.Printf("error: %s\n", err.Error())
+				return 1
+			}
+			fmt.Println(out)
+		}
+		return 0
+	}
+
+	ret := 0
+
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		out, err := b.convert(scanner.Text())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "warning: %s\n", err.Error())
+			ret = 1
+			continue
+		}
+		fmt.Println(out)
+	}
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintf(os.Stderr, "error reading STDIN: %s\n", err.Error())
+		return 1
+	}
+
+	return ret
+}
@@ -2,23 +2,72 @@ package main
 
 import (
 	"bufio"
+	"flag"
 	"fmt"
 	"go/ast"
 	"go/parser"
+	"go/scanner"
 	"go/token"
-	"log"
+	"io"
+	"math"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 
-	"github.com/skx/subcommands"
+	"github.com/chzyer/readline"
+	"golang.org/x/crypto/ssh/terminal"
 )
 
 // Structure for our options and state.
 type calcCommand struct {
 
-	// We embed the NoFlags option, because we accept no command-line flags.
-	subcommands.NoFlags
+	// Show results in hexadecimal?
+	hex bool
+
+	// Show results in octal?
+	oct bool
+
+	// Show results in binary?
+	bin bool
+
+	// The number of digits to show after the decimal point, or -1
+	// to show a trimmed result.
+	precision int
+
+	// The result of the previous successful evaluation, available
+	// to expressions as "ans".
+	ans float64
+
+	// Do we have a previous result?
+	haveAns bool
+
+	// Variables the user has assigned, via "name = value".
+	variables map[string]float64
+
+	// A file to read expressions from.
+	file string
+
+	// Are angles in degrees, rather than radians?
+	degrees bool
+
+	// Should we separate groups of thousands with commas?
+	group bool
+
+	// Is "^" exclusive-or, rather than exponentiation?
+	xor bool
+}
+
+// Arguments adds per-command args to the object.
+func (c *calcCommand) Arguments(f *flag.FlagSet) {
+	f.BoolVar(&c.hex, "hex", false, "Show results in hexadecimal")
+	f.BoolVar(&c.oct, "oct", false, "Show results in octal")
+	f.BoolVar(&c.bin, "bin", false, "Show results in binary")
+	f.IntVar(&c.precision, "precision", -1, "The number of digits to show after the decimal point")
+	f.StringVar(&c.file, "f", "", "Read expressions from the given file")
+	f.BoolVar(&c.group, "group", false, "Separate groups of thousands with commas")
+	f.BoolVar(&c.degrees, "degrees", false, "Use degrees, rather than radians, for trigonometric functions")
+	f.BoolVar(&c.xor, "xor", false, "Treat '^' as bitwise exclusive-or, rather than exponentiation")
 }
 
 // Info returns the name of this subcommand.
@@ -31,109 +80,1054 @@ This command allows you to evaluate simple mathematical operations,
 with support for floating-point operations - something the standard
 'expr' command does not support.
 
+Exponentiation is supported via either '**' or '^', which bind more
+tightly than multiplication and are right-associative, so '2 ** 3 ** 2'
+is 512.
+
+The following functions, from the standard math library, may be used:
+
+   abs, acos, asin, atan, atan2, cbrt, ceil, cos, cosh, exp, floor,
+   hypot, log, log10, log2, pow, round, sin, sinh, sqrt, tan, tanh,
+   trunc
+
+The aggregate functions 'avg', 'max', 'min', and 'sum' accept two or
+more arguments, for example 'avg(1, 2, 3, 4)'.
+
+The 'round' function accepts an optional second argument, the number of
+decimal places to round to, for example 'round(3.14159, 2)'.
+
+Note that 'log' is the natural logarithm.  The trigonometric functions
+work in radians, unless the '-degrees' flag is used.
+
+Factorials may be calculated via 'N!', or 'fact(N)', and combinations
+and permutations via 'nCr(n, r)' and 'nPr(n, r)'.
+
+The constants 'e', 'phi', 'pi', and 'tau' are also available.
+
+Numbers may be written in hexadecimal, octal, or binary, via the '0x',
+'0o', and '0b' prefixes, and may contain '_' separators, for example
+'0xff', '0b1010', or '1_000_000'.
+
+The bitwise operators '&', '|', '&^', '<<', and '>>' may be used upon
+integer values.  Since '^' is used for exponentiation the exclusive-or
+operation is available as the function 'xor(a, b)', or the '-xor' flag
+may be used to make '^' exclusive-or, leaving '**' for exponentiation.
+
+The comparison operators '==', '!=', '<', '>', '<=', and '>=' return 1
+if the comparison is true, and 0 otherwise.  The logical operators '&&',
+'||', and '!' treat zero as false and any other value as true, again
+returning 1 or 0.
+
+By default results are shown in decimal, but integer results may be
+shown in hexadecimal, octal, or binary via the '-hex', '-oct', and '-bin'
+flags respectively.
+
+Floating-point results are shown with up to six decimal places, with any
+trailing zeros removed.  To show a fixed number of decimal places use
+the '-precision' flag.  The '-group' flag will separate each group of
+three digits, in the integer part of the result, with a comma.
+
 Example:
 
    $ sysbox calc 3 + 3
    $ sysbox calc '1 / 3 * 9'
+   $ sysbox calc '2 ** 10'
+   $ sysbox calc 'sqrt(2) * sqrt(2)'
+   $ sysbox calc 'pi * 5 * 5'
+   $ sysbox calc '5!'
+   $ sysbox calc -degrees 'sin(90)'
+   $ sysbox calc '1 << 8'
+   $ sysbox calc '0xff & 0x0f'
+   $ sysbox calc '3 > 2'
+   $ sysbox calc -hex '255 + 1'
+   $ sysbox calc -precision 2 '1 / 3'
+   $ sysbox calc -group '1000000 * 5'
 
 Note here we can join arguments, or accept a quoted string.  The arguments
 must be quoted if you use '*' because otherwise the shell's globbing would
-cause surprises.`
+cause surprises.
+
+Expressions may also be read from a file, via '-f', or piped in upon
+STDIN.  In either case each line is evaluated in turn, and blank lines
+and lines beginning with '#' are ignored:
+
+   $ echo '1 + 2' | sysbox calc
+   $ sysbox calc -f expressions.txt
+
+If no expression is given an interactive prompt is started, which allows
+you to edit input, and recall previous expressions via the arrow keys.
+History is saved to ~/.sysbox_calc_history.
+
+Within the interactive prompt the result of the previous expression is
+available as 'ans', unless that expression failed.  You may also assign
+values to variables, and use them in later expressions:
+
+   calc> r = 10
+   10
+   calc> pi * r * r
+   314.159265
+
+An expression which begins with '-' would be confused with a flag, so
+separate it with '--':
+
+   $ sysbox calc -- '-(3 + 4)'`
+}
+
+// calcToken holds a single token of our input.
+type calcToken struct {
+	tok token.Token
+	lit string
+
+	// pos is the offset of the token within the input.  Tokens we
+	// insert when rewriting have the position of the operator they
+	// replaced.
+	pos int
+}
+
+// calcSyntaxError is an error parsing our input.
+type calcSyntaxError struct {
+
+	// input is the text we failed to parse.
+	input string
+
+	// offset is the position within the input of the problem.
+	offset int
+
+	// msg describes the problem.
+	msg string
+}
+
+// Error shows the input, with a caret pointing at the problem.
+func (e *calcSyntaxError) Error() string {
+
+	input := strings.TrimRight(e.input, " ")
+	offset := e.offset
+	if offset > len(input) {
+		offset = len(input)
+	}
+
+	//
+	// Pad the caret to line up with the input, keeping tabs so
+	// that we match the width of the input.
+	//
+	var pad strings.Builder
+	for _, r := range input[:offset] {
+		if r == '\t' {
+			pad.WriteRune('\t')
+		} else {
+			pad.WriteRune(' ')
+		}
+	}
+
+	return fmt.Sprintf("failed to parse: %s\n\t%s\n\t%s^", e.msg, input, pad.String())
+}
+
+// tokenize splits the given input into a series of tokens.
+func (c *calcCommand) tokenize(input string) []calcToken {
+
+	var s scanner.Scanner
+
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(input))
+	s.Init(file, []byte(input), nil, 0)
+
+	var toks []calcToken
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+
+		// The scanner inserts a semicolon at the end of the input.
+		if tok == token.SEMICOLON && lit == "\n" {
+			continue
+		}
+
+		if lit == "" {
+			lit = tok.String()
+		}
+
+		//
+		// "**" is scanned as two multiplications, so collapse
+		// them into a single exponentiation operator, which we
+		// represent via the "^" token.
+		//
+		n := len(toks)
+		if tok == token.MUL && n > 0 && toks[n-1].tok == token.MUL {
+			toks[n-1] = calcToken{tok: token.XOR, lit: "**", pos: toks[n-1].pos}
+			continue
+		}
+
+		toks = append(toks, calcToken{tok: tok, lit: lit, pos: file.Offset(pos)})
+	}
+
+	return toks
+}
+
+// closingParen returns the offset just beyond the parenthesis which
+// closes the one opened at the given offset, or -1 if it is unbalanced.
+func (c *calcCommand) closingParen(toks []calcToken, i int) int {
+	depth := 0
+	for ; i < len(toks); i++ {
+		switch toks[i].tok {
+		case token.LPAREN:
+			depth++
+		case token.RPAREN:
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return -1
+}
+
+// operandBefore returns the offset at which the operand which finishes
+// just before the given offset begins, or -1 if there is no operand.
+func (c *calcCommand) operandBefore(toks []calcToken, i int) int {
+	j := i - 1
+	if j < 0 {
+		return -1
+	}
+
+	switch toks[j].tok {
+	case token.INT, token.FLOAT, token.IDENT:
+		return j
+
+	case token.RPAREN:
+		depth := 0
+		for ; j >= 0; j-- {
+			switch toks[j].tok {
+			case token.RPAREN:
+				depth++
+			case token.LPAREN:
+				depth--
+				if depth == 0 {
+					// Include the name of a function-call.
+					if j > 0 && toks[j-1].tok == token.IDENT {
+						return j - 1
+					}
+					return j
+				}
+			}
+		}
+	}
+
+	return -1
+}
+
+// operandAfter returns the offset just beyond the operand which begins
+// at the given offset, or -1 if there is no operand.
+func (c *calcCommand) operandAfter(toks []calcToken, i int) int {
+
+	// Skip any leading sign.
+	for i < len(toks) && (toks[i].tok == token.ADD || toks[i].tok == token.SUB) {
+		i++
+	}
+	if i >= len(toks) {
+		return -1
+	}
+
+	switch toks[i].tok {
+	case token.INT, token.FLOAT:
+		return i + 1
+	case token.IDENT:
+		if i+1 < len(toks) && toks[i+1].tok == token.LPAREN {
+			return c.closingParen(toks, i+1)
+		}
+		return i + 1
+	case token.LPAREN:
+		return c.closingParen(toks, i)
+	}
+
+	return -1
+}
+
+// rewrite converts the operators the go parser does not understand into
+// function calls.
+//
+// The postfix factorial operator "N!" becomes "fact(N)".  We can tell it
+// apart from the prefix logical-not because it follows an operand.
+//
+// We cannot simply treat "^" as a binary operator, because the go parser
+// gives it the same precedence as addition.  Instead "a ^ b" becomes
+// "pow(a, b)".  Processing the operators from right to left gives us
+// the expected right-associativity.
+func (c *calcCommand) rewrite(input string, toks []calcToken) ([]calcToken, error) {
+
+	for i := 1; i < len(toks); i++ {
+		if toks[i].tok != token.NOT {
+			continue
+		}
+		start := c.operandBefore(toks, i)
+		if start < 0 {
+			continue
+		}
+
+		pos := toks[i].pos
+
+		var out []calcToken
+		out = append(out, toks[:start]...)
+		out = append(out,
+			calcToken{tok: token.IDENT, lit: "fact", pos: pos},
+			calcToken{tok: token.LPAREN, lit: "(", pos: pos})
+		out = append(out, toks[start:i]...)
+		out = append(out, calcToken{tok: token.RPAREN, lit: ")", pos: pos})
+		out = append(out, toks[i+1:]...)
+		toks = out
+	}
+
+	// "^" is exclusive-or, rather than exponentiation, if we were asked.
+	power := func(t calcToken) bool {
+		return t.tok == token.XOR && (t.lit == "**" || !c.xor)
+	}
+
+	for {
+		i := len(toks) - 1
+		for i >= 0 && !power(toks[i]) {
+			i--
+		}
+		if i < 0 {
+			return toks, nil
+		}
+
+		pos := toks[i].pos
+
+		start := c.operandBefore(toks, i)
+		end := c.operandAfter(toks, i+1)
+		if start < 0 || end < 0 {
+			return nil, &calcSyntaxError{input: input, offset: pos,
+				msg: fmt.Sprintf("missing operand for '%s'", toks[i].lit)}
+		}
+
+		var out []calcToken
+		out = append(out, toks[:start]...)
+		out = append(out,
+			calcToken{tok: token.IDENT, lit: "pow", pos: pos},
+			calcToken{tok: token.LPAREN, lit: "(", pos: pos})
+		out = append(out, toks[start:i]...)
+		out = append(out, calcToken{tok: token.COMMA, lit: ",", pos: pos})
+		out = append(out, toks[i+1:end]...)
+		out = append(out, calcToken{tok: token.RPAREN, lit: ")", pos: pos})
+		out = append(out, toks[end:]...)
+		toks = out
+	}
+}
+
+// calcFunction describes a function which may be called from an expression.
+type calcFunction struct {
+
+	// minArgs is the minimum number of arguments the function requires.
+	minArgs int
+
+	// maxArgs is the maximum number of arguments the function accepts,
+	// or -1 if there is no limit.
+	maxArgs int
+
+	// fn implements the function.
+	fn func(args []float64) (float64, error)
+
+	// angleIn is true if the arguments are angles, in radians.
+	angleIn bool
+
+	// angleOut is true if the result is an angle, in radians.
+	angleOut bool
+}
+
+// calcUnary wraps a single-argument function from the math package.
+func calcUnary(fn func(float64) float64) calcFunction {
+	return calcFunction{minArgs: 1, maxArgs: 1, fn: func(a []float64) (float64, error) {
+		return fn(a[0]), nil
+	}}
+}
+
+// calcBinary wraps a two-argument function from the math package.
+func calcBinary(fn func(float64, float64) float64) calcFunction {
+	return calcFunction{minArgs: 2, maxArgs: 2, fn: func(a []float64) (float64, error) {
+		return fn(a[0], a[1]), nil
+	}}
+}
+
+// calcTrig marks a function as taking an angle as its argument.
+func calcTrig(fn calcFunction) calcFunction {
+	fn.angleIn = true
+	return fn
+}
+
+// calcInverseTrig marks a function as returning an angle.
+func calcInverseTrig(fn calcFunction) calcFunction {
+	fn.angleOut = true
+	return fn
+}
+
+// calcInteger converts the given value to an integer, returning an error
+// if it has a fractional part, or is out of range.
+func calcInteger(val float64) (int64, error) {
+	if val != math.Trunc(val) || math.Abs(val) >= 1<<63 {
+		return 0, fmt.Errorf("%v is not an integer", val)
+	}
+	return int64(val), nil
+}
+
+// calcBool converts a boolean to the value we use to represent it.
+func calcBool(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// calcSum returns the sum of the given values.
+func calcSum(vals []float64) float64 {
+	sum := 0.0
+	for _, v := range vals {
+		sum += v
+	}
+	return sum
+}
+
+// calcFactorial returns the product of the integers in the range [from, to].
+func calcFactorial(from int64, to int64) (float64, error) {
+	res := 1.0
+	for i := from; i <= to; i++ {
+		res *= float64(i)
+		if math.IsInf(res, 0) {
+			return 0, fmt.Errorf("overflow")
+		}
+	}
+	return res, nil
+}
+
+// calcCombinatoric validates the arguments to nCr and nPr, which must be
+// integers with 0 <= r <= n.
+func calcCombinatoric(a []float64) (int64, int64, error) {
+	n, err := calcInteger(a[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	r, err := calcInteger(a[1])
+	if err != nil {
+		return 0, 0, err
+	}
+	if r < 0 || n < r {
+		return 0, 0, fmt.Errorf("invalid arguments %d and %d, need 0 <= r <= n", n, r)
+	}
+	return n, r, nil
+}
+
+// calcFunctions contains the functions which expressions may call.
+var calcFunctions = map[string]calcFunction{
+	"abs":   calcUnary(math.Abs),
+	"acos":  calcInverseTrig(calcUnary(math.Acos)),
+	"asin":  calcInverseTrig(calcUnary(math.Asin)),
+	"atan":  calcInverseTrig(calcUnary(math.Atan)),
+	"atan2": calcInverseTrig(calcBinary(math.Atan2)),
+	"avg": {minArgs: 2, maxArgs: -1, fn: func(a []float64) (float64, error) {
+		return calcSum(a) / float64(len(a)), nil
+	}},
+	"cbrt": calcUnary(math.Cbrt),
+	"ceil": calcUnary(math.Ceil),
+	"cos":  calcTrig(calcUnary(math.Cos)),
+	"cosh": calcUnary(math.Cosh),
+	"exp":  calcUnary(math.Exp),
+	"fact": {minArgs: 1, maxArgs: 1, fn: func(a []float64) (float64, error) {
+		n, err := calcInteger(a[0])
+		if err != nil {
+			return 0, err
+		}
+		if n < 0 {
+			return 0, fmt.Errorf("factorial of negative number %d", n)
+		}
+		return calcFactorial(2, n)
+	}},
+	"floor": calcUnary(math.Floor),
+	"hypot": calcBinary(math.Hypot),
+	"log":   calcUnary(math.Log),
+	"log10": calcUnary(math.Log10),
+	"log2":  calcUnary(math.Log2),
+	"max": {minArgs: 2, maxArgs: -1, fn: func(a []float64) (float64, error) {
+		res := a[0]
+		for _, v := range a[1:] {
+			res = math.Max(res, v)
+		}
+		return res, nil
+	}},
+	"min": {minArgs: 2, maxArgs: -1, fn: func(a []float64) (float64, error) {
+		res := a[0]
+		for _, v := range a[1:] {
+			res = math.Min(res, v)
+		}
+		return res, nil
+	}},
+	"nCr": {minArgs: 2, maxArgs: 2, fn: func(a []float64) (float64, error) {
+		n, r, err := calcCombinatoric(a)
+		if err != nil {
+			return 0, err
+		}
+		if r > n-r {
+			r = n - r
+		}
+
+		// n! / (r! (n-r)!), calculated incrementally
+		res := 1.0
+		for i := int64(1); i <= r; i++ {
+			res = res * float64(n-r+i) / float64(i)
+			if math.IsInf(res, 0) {
+				return 0, fmt.Errorf("overflow")
+			}
+		}
+		return math.Round(res), nil
+	}},
+	"nPr": {minArgs: 2, maxArgs: 2, fn: func(a []float64) (float64, error) {
+		n, r, err := calcCombinatoric(a)
+		if err != nil {
+			return 0, err
+		}
+		return calcFactorial(n-r+1, n)
+	}},
+	"pow": calcBinary(math.Pow),
+	"round": {minArgs: 1, maxArgs: 2, fn: func(a []float64) (float64, error) {
+		if len(a) == 1 {
+			return math.Round(a[0]), nil
+		}
+
+		// Round to the given number of decimal places.
+		places, err := calcInteger(a[1])
+		if err != nil {
+			return 0, err
+		}
+		scale := math.Pow(10, float64(places))
+		return math.Round(a[0]*scale) / scale, nil
+	}},
+	"sin":  calcTrig(calcUnary(math.Sin)),
+	"sinh": calcUnary(math.Sinh),
+	"sqrt": calcUnary(math.Sqrt),
+	"sum": {minArgs: 2, maxArgs: -1, fn: func(a []float64) (float64, error) {
+		return calcSum(a), nil
+	}},
+	"tan":   calcTrig(calcUnary(math.Tan)),
+	"tanh":  calcUnary(math.Tanh),
+	"trunc": calcUnary(math.Trunc),
+
+	// "^" is exponentiation, unless "-xor" is used, so exclusive-or
+	// is available as a function too.
+	"xor": {minArgs: 2, maxArgs: 2, fn: func(a []float64) (float64, error) {
+		x, err := calcInteger(a[0])
+		if err != nil {
+			return 0, err
+		}
+		y, err := calcInteger(a[1])
+		if err != nil {
+			return 0, err
+		}
+		return float64(x ^ y), nil
+	}},
+}
+
+// calcConstants contains the named constants which expressions may use.
+var calcConstants = map[string]float64{
+	"e":   math.E,
+	"phi": math.Phi,
+	"pi":  math.Pi,
+	"tau": 2 * math.Pi,
 }
 
 // eval evaluates the given AST expression.
-func (c *calcCommand) eval(exp ast.Expr) float64 {
+func (c *calcCommand) eval(exp ast.Expr) (float64, error) {
 	switch exp := exp.(type) {
 
-	// ! and -
+	// "1 + 2", "3 * 4", etc.
 	case *ast.BinaryExpr:
 		return c.evalBinaryExpr(exp)
 
 	// numbers (+ strings, etc)
 	case *ast.BasicLit:
 		switch exp.Kind {
-		case token.INT, token.FLOAT:
-			i, _ := strconv.ParseFloat(exp.Value, 64)
-			return i
+		case token.INT:
+			//
+			// Handle the "0x", "0o", and "0b" prefixes, as well
+			// as "_" separators.  Values too large for an int64
+			// are handled as floats.
+			//
+			i, err := strconv.ParseInt(exp.Value, 0, 64)
+			if err == nil {
+				return float64(i), nil
+			}
+			return strconv.ParseFloat(exp.Value, 64)
+		case token.FLOAT:
+			return strconv.ParseFloat(exp.Value, 64)
 		default:
-			fmt.Printf("unknown literal type: %v %T\n", exp, exp)
-			os.Exit(1)
+			return 0, fmt.Errorf("unknown literal type: %v", exp.Value)
+		}
+
+	// named constants (e.g. "pi * 2".)
+	case *ast.Ident:
+		if val, ok := calcConstants[exp.Name]; ok {
+			return val, nil
+		}
+		if val, ok := c.variables[exp.Name]; ok {
+			return val, nil
+		}
+		if exp.Name == "ans" && c.haveAns {
+			return c.ans, nil
 		}
+		return 0, fmt.Errorf("unknown identifier '%s'", exp.Name)
 
 	// parenthesis (e.g. "(1 + 2 ) * 3".)
 	case *ast.ParenExpr:
-		return (c.eval(exp.X))
+		return c.eval(exp.X)
 
-	default:
-		fmt.Printf("unknown ast.Node: %v %T\n", exp, exp)
-		os.Exit(1)
+	// function calls (e.g. "sqrt(16)".)
+	case *ast.CallExpr:
+		return c.evalCallExpr(exp)
 
+	// unary operators (e.g. "-(3 + 4)".)
+	case *ast.UnaryExpr:
+		return c.evalUnaryExpr(exp)
 	}
 
-	return 0
+	return 0, fmt.Errorf("unknown ast.Node: %T", exp)
+}
+
+// evalUnaryExpr evaluates a unary operation, such as negation.
+func (c *calcCommand) evalUnaryExpr(exp *ast.UnaryExpr) (float64, error) {
+	val, err := c.eval(exp.X)
+	if err != nil {
+		return 0, err
+	}
+
+	switch exp.Op {
+	case token.ADD:
+		return val, nil
+	case token.SUB:
+		return -val, nil
+	case token.NOT:
+		return calcBool(val == 0), nil
+	}
+
+	return 0, fmt.Errorf("unknown operator '%v'", exp.Op)
 }
 
 // evalBinaryExpr evaluate a binary operation (which means there are
 // two arguments).
-func (c *calcCommand) evalBinaryExpr(exp *ast.BinaryExpr) float64 {
-	left := c.eval(exp.X)
-	right := c.eval(exp.Y)
+func (c *calcCommand) evalBinaryExpr(exp *ast.BinaryExpr) (float64, error) {
+	left, err := c.eval(exp.X)
+	if err != nil {
+		return 0, err
+	}
+
+	//
+	// The logical operators short-circuit, so we only evaluate
+	// the right-hand side if we need to.
+	//
+	if exp.Op == token.LAND && left == 0 {
+		return 0, nil
+	}
+	if exp.Op == token.LOR && left != 0 {
+		return 1, nil
+	}
+
+	right, err := c.eval(exp.Y)
+	if err != nil {
+		return 0, err
+	}
 
 	switch exp.Op {
+	case token.LAND, token.LOR:
+		return calcBool(right != 0), nil
+	case token.EQL:
+		return calcBool(left == right), nil
+	case token.NEQ:
+		return calcBool(left != right), nil
+	case token.LSS:
+		return calcBool(left < right), nil
+	case token.GTR:
+		return calcBool(left > right), nil
+	case token.LEQ:
+		return calcBool(left <= right), nil
+	case token.GEQ:
+		return calcBool(left >= right), nil
 	case token.ADD:
-		return left + right
+		return left + right, nil
 	case token.SUB:
-		return left - right
+		return left - right, nil
 	case token.MUL:
-		return left * right
+		return left * right, nil
 	case token.QUO:
-		return left / right
+		if right == 0 {
+			return 0, fmt.Errorf("division by zero")
+		}
+		return left / right, nil
 	case token.REM:
-		// modulus
-		return float64(int(left) % int(right))
+		// modulus, which takes the sign of the left-hand side
+		if right == 0 {
+			return 0, fmt.Errorf("division by zero")
+		}
+		return math.Mod(left, right), nil
+	case token.AND, token.OR, token.XOR, token.AND_NOT, token.SHL, token.SHR:
+		return c.evalBitwise(exp.Op, left, right)
 	}
 
-	fmt.Printf("Unknown operator '%v'\n", exp.Op)
-	os.Exit(1)
-	return 0
+	return 0, fmt.Errorf("unknown operator '%v'", exp.Op)
 }
 
-// Evaluate processes the given string.
-func (c *calcCommand) Evaluate(input string) error {
+// evalBitwise evaluates a bitwise operation, which requires that both
+// arguments are integers.
+func (c *calcCommand) evalBitwise(op token.Token, left float64, right float64) (float64, error) {
+	x, err := calcInteger(left)
+	if err != nil {
+		return 0, fmt.Errorf("operator '%v': %s", op, err)
+	}
+	y, err := calcInteger(right)
+	if err != nil {
+		return 0, fmt.Errorf("operator '%v': %s", op, err)
+	}
+
+	switch op {
+	case token.AND:
+		return float64(x & y), nil
+	case token.OR:
+		return float64(x | y), nil
+	case token.XOR:
+		return float64(x ^ y), nil
+	case token.AND_NOT:
+		return float64(x &^ y), nil
+	case token.SHL, token.SHR:
+		if y < 0 || y > 63 {
+			return 0, fmt.Errorf("invalid shift count %d", y)
+		}
+		if op == token.SHL {
+			return float64(x << uint(y)), nil
+		}
+		return float64(x >> uint(y)), nil
+	}
+
+	return 0, fmt.Errorf("unknown operator '%v'", op)
+}
+
+// evalCallExpr evaluates a function call.
+func (c *calcCommand) evalCallExpr(exp *ast.CallExpr) (float64, error) {
+	ident, ok := exp.Fun.(*ast.Ident)
+	if !ok {
+		return 0, fmt.Errorf("invalid function call")
+	}
+
+	fn, ok := calcFunctions[ident.Name]
+	if !ok {
+		return 0, fmt.Errorf("unknown function '%s'", ident.Name)
+	}
+
+	if len(exp.Args) < fn.minArgs || (fn.maxArgs >= 0 && len(exp.Args) > fn.maxArgs) {
+		switch fn.maxArgs {
+		case fn.minArgs:
+			return 0, fmt.Errorf("%s expects %d argument(s), got %d",
+				ident.Name, fn.minArgs, len(exp.Args))
+		case -1:
+			return 0, fmt.Errorf("%s expects at least %d argument(s), got %d",
+				ident.Name, fn.minArgs, len(exp.Args))
+		}
+		return 0, fmt.Errorf("%s expects between %d and %d arguments, got %d",
+			ident.Name, fn.minArgs, fn.maxArgs, len(exp.Args))
+	}
+
+	var args []float64
+	for _, arg := range exp.Args {
+		val, err := c.eval(arg)
+		if err != nil {
+			return 0, err
+		}
+		args = append(args, val)
+	}
+
+	//
+	// Convert angles from degrees, if we should.
+	//
+	if c.degrees && fn.angleIn {
+		for i := range args {
+			args[i] = args[i] * math.Pi / 180
+		}
+	}
+
+	res, err := fn.fn(args)
+	if err != nil {
+		return 0, err
+	}
+
+	//
+	// Convert angles to degrees, if we should.
+	//
+	if c.degrees && fn.angleOut {
+		res = res * 180 / math.Pi
+	}
+
+	return res, nil
+}
+
+// calculate parses and evaluates the given string, returning the result.
+//
+// If the input is an assignment, such as "x = 5", then the variable is
+// stored and the assigned value is returned.
+func (c *calcCommand) calculate(input string) (float64, error) {
+
+	toks := c.tokenize(input)
+
+	if len(toks) >= 2 && toks[0].tok == token.IDENT && toks[1].tok == token.ASSIGN {
+		name := toks[0].lit
+		if _, ok := calcConstants[name]; ok || name == "ans" {
+			return 0, fmt.Errorf("cannot assign to reserved name '%s'", name)
+		}
+
+		val, err := c.calculateTokens(input, toks[2:])
+		if err != nil {
+			return 0, err
+		}
+
+		if c.variables == nil {
+			c.variables = make(map[string]float64)
+		}
+		c.variables[name] = val
+		return val, nil
+	}
+
+	return c.calculateTokens(input, toks)
+}
+
+// calculateTokens parses and evaluates the tokens of the given input.
+func (c *calcCommand) calculateTokens(input string, toks []calcToken) (float64, error) {
+
+	//
+	// Rewrite the operators the go parser doesn't understand.
+	//
+	toks, err := c.rewrite(input, toks)
+	if err != nil {
+		return 0, err
+	}
+
+	//
+	// Join the tokens, recording where each one begins so that we
+	// can map the position of any parse error back to our input.
+	//
+	var src strings.Builder
+	var starts []int
+	for _, t := range toks {
+		starts = append(starts, src.Len())
+		src.WriteString(t.lit)
+		src.WriteString(" ")
+	}
+
+	// offset maps a position in the source we parse to our input.
+	offset := func(pos int) int {
+
+		// By default the problem is at the end of the input.
+		res := len(input)
+		for i, start := range starts {
+			if start <= pos {
+				res = toks[i].pos
+			}
+		}
+		if pos >= src.Len()-1 {
+			res = len(input)
+		}
+		return res
+	}
 
 	//
 	// Parse to AST
 	//
-	exp, err := parser.ParseExpr(input)
+	fset := token.NewFileSet()
+	exp, err := parser.ParseExprFrom(fset, "", src.String(), 0)
 	if err != nil {
-		return fmt.Errorf("failed to parse '%s': %s", input, err)
+		list, ok := err.(scanner.ErrorList)
+		if !ok || len(list) == 0 {
+			return 0, fmt.Errorf("failed to parse '%s': %s", input, err)
+		}
+		return 0, &calcSyntaxError{input: input, offset: offset(list[0].Pos.Offset), msg: list[0].Msg}
+	}
+
+	//
+	// The go parser accepts things we can't evaluate, such as the
+	// dereference in "2 + * 3", so report those as syntax errors too.
+	//
+	if node := c.unsupported(exp); node != nil {
+		pos := fset.Position(node.Pos()).Offset
+		msg := "unexpected expression"
+		for i, start := range starts {
+			if start == pos {
+				msg = fmt.Sprintf("unexpected '%s'", toks[i].lit)
+			}
+		}
+		return 0, &calcSyntaxError{input: input, offset: offset(pos), msg: msg}
 	}
 
 	//
 	// Evaluate
 	//
-	res := c.eval(exp)
+	return c.eval(exp)
+}
+
+// unsupported returns the first node of the expression which we cannot
+// evaluate, or nil if there are none.
+func (c *calcCommand) unsupported(exp ast.Expr) ast.Node {
+
+	var bad ast.Node
+	ast.Inspect(exp, func(n ast.Node) bool {
+		if bad != nil {
+			return false
+		}
+
+		switch n := n.(type) {
+		case nil, *ast.BinaryExpr, *ast.BasicLit, *ast.Ident, *ast.ParenExpr, *ast.CallExpr:
+			return true
+		case *ast.UnaryExpr:
+			if n.Op == token.ADD || n.Op == token.SUB || n.Op == token.NOT {
+				return true
+			}
+		}
+
+		bad = n
+		return false
+	})
+	return bad
+}
+
+// Evaluate processes the given string, and shows the result.
+//
+// The result is remembered, so that it can be used in the next
+// expression as "ans".
+func (c *calcCommand) Evaluate(input string) error {
+
+	res, err := c.calculate(input)
+
+	out := ""
+	if err == nil {
+		out, err = c.format(res)
+	}
+
+	if err != nil {
+		c.haveAns = false
+		return err
+	}
+
+	c.ans = res
+	c.haveAns = true
+
+	fmt.Printf("%s\n", out)
+	return nil
+}
+
+// format converts the result of an evaluation into a string for display.
+func (c *calcCommand) format(res float64) (string, error) {
 
 	//
-	// If the result is an int show that, to avoid
-	// needless ".0000" suffix.
+	// Showing the result in a different base?
 	//
-	if res == float64(int(res)) {
-		fmt.Printf("%d\n", int(res))
+	if c.hex || c.oct || c.bin {
+		i, err := calcInteger(res)
+		if err != nil {
+			return "", fmt.Errorf("cannot show result in a different base: %s", err)
+		}
+
+		sign := ""
+		if i < 0 {
+			sign = "-"
+			i = -i
+		}
+
+		switch {
+		case c.hex:
+			return fmt.Sprintf("%s0x%x", sign, i), nil
+		case c.oct:
+			return fmt.Sprintf("%s0o%o", sign, i), nil
+		default:
+			return fmt.Sprintf("%s0b%b", sign, i), nil
+		}
+	}
+
+	out := ""
+
+	if c.precision >= 0 {
+
+		//
+		// If the user specified a precision then use it.
+		//
+		out = strconv.FormatFloat(res, 'f', c.precision, 64)
+
+	} else if i, err := calcInteger(res); err == nil {
+
+		//
+		// If the result is an int show that, to avoid
+		// needless ".0000" suffix.
+		//
+		out = fmt.Sprintf("%d", i)
+
 	} else {
 
 		//
-		// OK show the floating-point result.
+		// OK show the floating-point result, without trailing zeros.
 		//
-		fmt.Printf("%f\n", res)
+		out = strconv.FormatFloat(res, 'f', 6, 64)
+		if strings.Contains(out, ".") {
+			out = strings.TrimRight(out, "0")
+			out = strings.TrimSuffix(out, ".")
+		}
 	}
 
-	return nil
+	if c.group {
+		out = c.thousands(out)
+	}
+	return out, nil
+}
+
+// thousands inserts a comma between each group of three digits in the
+// integer part of the given number.
+func (c *calcCommand) thousands(num string) string {
+
+	sign := ""
+	if strings.HasPrefix(num, "-") {
+		sign = "-"
+		num = num[1:]
+	}
+
+	// The fractional part is left alone.
+	frac := ""
+	if i := strings.Index(num, "."); i >= 0 {
+		frac = num[i:]
+		num = num[:i]
+	}
+
+	// Leave "+Inf", "NaN", etc, alone.
+	if strings.Trim(num, "0123456789") != "" {
+		return sign + num + frac
+	}
+
+	var out strings.Builder
+	for i, r := range num {
+		if i > 0 && (len(num)-i)%3 == 0 {
+			out.WriteRune(',')
+		}
+		out.WriteRune(r)
+	}
+
+	return sign + out.String() + frac
 }
 
 // Execute is invoked if the user specifies `calc` as the subcommand.
 func (c *calcCommand) Execute(args []string) int {
 
+	//
+	// Only a single output-base may be chosen.
+	//
+	bases := 0
+	for _, set := range []bool{c.hex, c.oct, c.bin} {
+		if set {
+			bases++
+		}
+	}
+	if bases > 1 {
+		fmt.Printf("Only one of -hex, -oct, and -bin may be used\n")
+		return 1
+	}
+
 	//
 	// Join all arguments, in case we have been given "3", "+", "4".
 	//
@@ -159,20 +1153,68 @@ func (c *calcCommand) Execute(args []string) int {
 	}
 
 	//
-	// Repl.
+	// Reading from a file?
 	//
-	scanner := bufio.NewScanner(os.Stdin)
+	if c.file != "" {
+		handle, err := os.Open(c.file)
+		if err != nil {
+			fmt.Printf("ERROR: %s\n", err.Error())
+			return 1
+		}
+		defer handle.Close()
+
+		return c.processReader(handle)
+	}
 
 	//
-	// Show the prompt and read the lines
+	// If STDIN isn't a terminal then we're reading from a pipe.
 	//
-	fmt.Printf("calc> ")
-	for scanner.Scan() {
+	if !terminal.IsTerminal(int(os.Stdin.Fd())) {
+		return c.processReader(os.Stdin)
+	}
+
+	//
+	// Repl.
+	//
+	// We use readline to allow editing, and history.
+	//
+	rl, err := readline.NewEx(&readline.Config{
+		Prompt:                 "calc> ",
+		HistoryFile:            c.historyFile(),
+		DisableAutoSaveHistory: true,
+	})
+	if err != nil {
+		fmt.Printf("Error starting the REPL: %s\n", err.Error())
+		return 1
+	}
+	defer rl.Close()
+
+	for {
+
+		//
+		// Read a line of input
+		//
+		input, err := rl.Readline()
+		if err == readline.ErrInterrupt {
+
+			// Ctrl-C clears the current line, or exits if
+			// there is nothing to clear.
+			if input == "" {
+				return 0
+			}
+			continue
+		}
+		if err == io.EOF {
+			return 0
+		}
+		if err != nil {
+			fmt.Printf("Error reading input: %s\n", err.Error())
+			return 1
+		}
 
 		//
-		// Get the input, and trim it
+		// Trim it
 		//
-		input := scanner.Text()
 		input = strings.TrimSpace(input)
 
 		//
@@ -186,27 +1228,67 @@ func (c *calcCommand) Execute(args []string) int {
 		//
 		// Ignore it, unless it is non-empty
 		//
-		if input != "" {
+		if input == "" {
+			continue
+		}
 
-			//
-			// Evaluate it
-			//
-			err := c.Evaluate(input)
-			if err != nil {
-				fmt.Printf("ERROR: %s\n", err.Error())
-				return 1
-			}
+		//
+		// Evaluate it, errors are reported but are not fatal.
+		//
+		err = c.Evaluate(input)
+		if err != nil {
+			fmt.Printf("ERROR: %s\n", err.Error())
+		}
+
+		//
+		// Record it in our history.
+		//
+		rl.SaveHistory(input)
+	}
+}
+
+// processReader evaluates each line read from the given reader, skipping
+// blank lines and comments.
+//
+// Errors are reported but processing continues; the return value is
+// suitable for use as an exit-code.
+func (c *calcCommand) processReader(reader io.Reader) int {
+
+	ret := 0
+	line := 0
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line++
+
+		input := strings.TrimSpace(scanner.Text())
+		if input == "" || strings.HasPrefix(input, "#") {
+			continue
 		}
 
-		fmt.Printf("calc> ")
+		err := c.Evaluate(input)
+		if err != nil {
+			fmt.Printf("ERROR: line %d: %s\n", line, err.Error())
+			ret = 1
+		}
 	}
 
 	if err := scanner.Err(); err != nil {
-		log.Println(err)
+		fmt.Printf("ERROR: %s\n", err.Error())
+		return 1
 	}
 
-	//
-	// All done
-	//
-	return 0
+	return ret
+}
+
+// historyFile returns the path to the file our REPL history is saved to.
+//
+// If the home directory cannot be found we return "", which disables
+// the persistence of history.
+func (c *calcCommand) historyFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".sysbox_calc_history")
 }
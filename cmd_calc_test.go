@@ -0,0 +1,255 @@
+package main
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+// TestCalcPower tests the exponentiation operators.
+func TestCalcPower(t *testing.T) {
+
+	tests := []struct {
+		input    string
+		expected float64
+	}{
+		{"2 ** 10", 1024},
+		{"2 ^ 10", 1024},
+		{"0 ** 0", 1},
+		{"2 ^ 3 ^ 2", 512},
+		{"2 ** 3 ** 2", 512},
+		{"(2 ^ 3) ^ 2", 64},
+		{"2 * 3 ** 2", 18},
+		{"-2 ** 2", -4},
+		{"10 ** 400", math.Inf(1)},
+	}
+
+	for _, test := range tests {
+		c := &calcCommand{}
+		out, err := c.calculate(test.input)
+		if err != nil {
+			t.Fatalf("unexpected error calculating '%s': %s", test.input, err)
+		}
+		if out != test.expected {
+			t.Fatalf("'%s' gave %v, expected %v", test.input, out, test.expected)
+		}
+	}
+}
+
+// TestCalcFunctions tests each of the functions which may be called.
+func TestCalcFunctions(t *testing.T) {
+
+	tests := []struct {
+		input    string
+		expected float64
+		err      string
+	}{
+		{input: "abs(-3)", expected: 3},
+		{input: "acos(1)", expected: 0},
+		{input: "asin(0)", expected: 0},
+		{input: "atan(0)", expected: 0},
+		{input: "atan2(0, 1)", expected: 0},
+		{input: "avg(1, 2, 3, 4)", expected: 2.5},
+		{input: "cbrt(27)", expected: 3},
+		{input: "ceil(1.2)", expected: 2},
+		{input: "cos(0)", expected: 1},
+		{input: "cosh(0)", expected: 1},
+		{input: "exp(0)", expected: 1},
+		{input: "fact(5)", expected: 120},
+		{input: "floor(1.8)", expected: 1},
+		{input: "hypot(3, 4)", expected: 5},
+		{input: "log(7.38905609893065)", expected: 2},
+		{input: "log10(1000)", expected: 3},
+		{input: "log2(8)", expected: 3},
+		{input: "max(1, 7, 3)", expected: 7},
+		{input: "min(4, 2, 9)", expected: 2},
+		{input: "nCr(5, 2)", expected: 10},
+		{input: "nPr(5, 2)", expected: 20},
+		{input: "pow(2, 8)", expected: 256},
+		{input: "round(2.5)", expected: 3},
+		{input: "round(3.14159, 2)", expected: 3.14},
+		{input: "sin(0)", expected: 0},
+		{input: "sinh(0)", expected: 0},
+		{input: "sqrt(16)", expected: 4},
+		{input: "sum(1, 2, 3)", expected: 6},
+		{input: "tan(0)", expected: 0},
+		{input: "tanh(0)", expected: 0},
+		{input: "trunc(-1.7)", expected: -1},
+		{input: "xor(6, 3)", expected: 5},
+
+		// Argument-count errors.
+		{input: "abs()", err: "abs expects 1 argument(s), got 0"},
+		{input: "sqrt(1, 2)", err: "sqrt expects 1 argument(s), got 2"},
+		{input: "atan2(1)", err: "atan2 expects 2 argument(s), got 1"},
+		{input: "pow(1, 2, 3)", err: "pow expects 2 argument(s), got 3"},
+		{input: "fact()", err: "fact expects 1 argument(s), got 0"},
+		{input: "nCr(5)", err: "nCr expects 2 argument(s), got 1"},
+		{input: "xor(1, 2, 3)", err: "xor expects 2 argument(s), got 3"},
+		{input: "round()", err: "round expects between 1 and 2 arguments, got 0"},
+		{input: "round(1, 2, 3)", err: "round expects between 1 and 2 arguments, got 3"},
+		{input: "avg()", err: "avg expects at least 2 argument(s), got 0"},
+		{input: "max()", err: "max expects at least 2 argument(s), got 0"},
+		{input: "min()", err: "min expects at least 2 argument(s), got 0"},
+		{input: "sum()", err: "sum expects at least 2 argument(s), got 0"},
+		{input: "min(3)", err: "min expects at least 2 argument(s), got 1"},
+
+		// Other errors.
+		{input: "fact(-1)", err: "factorial of negative number -1"},
+		{input: "nCr(2, 5)", err: "invalid arguments 2 and 5, need 0 <= r <= n"},
+		{input: "xor(1.5, 2)", err: "1.5 is not an integer"},
+		{input: "nope(1)", err: "unknown function 'nope'"},
+	}
+
+	tested := make(map[string]bool)
+
+	for _, test := range tests {
+		c := &calcCommand{}
+		out, err := c.calculate(test.input)
+
+		if test.err != "" {
+			if err == nil {
+				t.Fatalf("expected an error calculating '%s', got %v", test.input, out)
+			}
+			if err.Error() != test.err {
+				t.Fatalf("'%s' gave error '%s', expected '%s'", test.input, err, test.err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("unexpected error calculating '%s': %s", test.input, err)
+		}
+		if math.Abs(out-test.expected) > 1e-9 {
+			t.Fatalf("'%s' gave %v, expected %v", test.input, out, test.expected)
+		}
+
+		for name := range calcFunctions {
+			if strings.HasPrefix(test.input, name+"(") {
+				tested[name] = true
+			}
+		}
+	}
+
+	for name := range calcFunctions {
+		if !tested[name] {
+			t.Errorf("function '%s' is not tested", name)
+		}
+	}
+}
+
+// TestCalcDivision tests division, and modulus.
+func TestCalcDivision(t *testing.T) {
+
+	tests := []struct {
+		input    string
+		expected float64
+		err      string
+	}{
+		{input: "7 / 2", expected: 3.5},
+		{input: "7 % 3", expected: 1},
+		{input: "-7 % 3", expected: -1},
+		{input: "5 % 0.5", expected: 0},
+		{input: "5.5 % 2", expected: 1.5},
+		{input: "1 / 0", err: "division by zero"},
+		{input: "5 % 0", err: "division by zero"},
+		{input: "5 % 0.0", err: "division by zero"},
+	}
+
+	for _, test := range tests {
+		c := &calcCommand{}
+		out, err := c.calculate(test.input)
+
+		if test.err != "" {
+			if err == nil {
+				t.Fatalf("expected an error calculating '%s', got %v", test.input, out)
+			}
+			if err.Error() != test.err {
+				t.Fatalf("'%s' gave error '%s', expected '%s'", test.input, err, test.err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("unexpected error calculating '%s': %s", test.input, err)
+		}
+		if out != test.expected {
+			t.Fatalf("'%s' gave %v, expected %v", test.input, out, test.expected)
+		}
+	}
+}
+
+// TestCalcBitwise tests the bitwise operators.
+func TestCalcBitwise(t *testing.T) {
+
+	tests := []struct {
+		input    string
+		xor      bool
+		expected float64
+		err      string
+	}{
+		{input: "0xff & 0x0f", expected: 15},
+		{input: "0xf0 | 0x0f", expected: 255},
+		{input: "0xff &^ 0x0f", expected: 240},
+		{input: "1 << 8", expected: 256},
+		{input: "256 >> 4", expected: 16},
+		{input: "6 ^ 3", expected: 216},
+		{input: "6 ^ 3", xor: true, expected: 5},
+		{input: "0xff ^ 0x0f", xor: true, expected: 240},
+		{input: "2 ** 10", xor: true, expected: 1024},
+		{input: "2 ** 3 ^ 1", xor: true, expected: 9},
+		{input: "1.5 & 1", err: "operator '&': 1.5 is not an integer"},
+		{input: "1.5 ^ 1", xor: true, err: "operator '^': 1.5 is not an integer"},
+		{input: "1 << 64", err: "invalid shift count 64"},
+	}
+
+	for _, test := range tests {
+		c := &calcCommand{xor: test.xor}
+		out, err := c.calculate(test.input)
+
+		if test.err != "" {
+			if err == nil {
+				t.Fatalf("expected an error calculating '%s', got %v", test.input, out)
+			}
+			if err.Error() != test.err {
+				t.Fatalf("'%s' gave error '%s', expected '%s'", test.input, err, test.err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("unexpected error calculating '%s': %s", test.input, err)
+		}
+		if out != test.expected {
+			t.Fatalf("'%s' gave %v, expected %v", test.input, out, test.expected)
+		}
+	}
+}
+
+// TestCalcSyntaxError tests that malformed input is reported with the
+// position of the problem.
+func TestCalcSyntaxError(t *testing.T) {
+
+	tests := []struct {
+		input  string
+		offset int
+		msg    string
+	}{
+		{"2 + * 3", 4, "unexpected '*'"},
+		{"2 + &3", 4, "unexpected '&'"},
+		{"1 +", 3, "expected operand, found 'EOF'"},
+		{"2 ** ", 2, "missing operand for '**'"},
+	}
+
+	for _, test := range tests {
+		c := &calcCommand{}
+		_, err := c.calculate(test.input)
+
+		syntax, ok := err.(*calcSyntaxError)
+		if !ok {
+			t.Fatalf("'%s' gave '%v', expected a syntax error", test.input, err)
+		}
+		if syntax.offset != test.offset || syntax.msg != test.msg {
+			t.Fatalf("'%s' gave '%s' at %d, expected '%s' at %d", test.input, syntax.msg, syntax.offset, test.msg, test.offset)
+		}
+	}
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// Structure for our options and state.
+type colorCommand struct {
+
+	// The percentage to lighten the color by.
+	lighten float64
+
+	// The percentage to darken the color by.
+	darken float64
+}
+
+// colorFunction matches colors such as "rgb(1, 2, 3)" or "hsl(1, 2%, 3%)".
+var colorFunction = regexp.MustCompile(`^(rgb|hsl)\(\s*([0-9.]+)\s*,\s*([0-9.]+)%?\s*,\s*([0-9.]+)%?\s*\)$`)
+
+// Arguments adds per-command args to the object.
+func (c *colorCommand) Arguments(f *flag.FlagSet) {
+	f.Float64Var(&c.lighten, "lighten", 0, "Lighten the color by the given percentage")
+	f.Float64Var(&c.darken, "darken", 0, "Darken the color by the given percentage")
+}
+
+// Info returns the name of this subcommand.
+func (c *colorCommand) Info() (string, string) {
+	return "color", `Convert colors between hex, RGB, and HSL.
+
+Details:
+
+This command shows the given color as hex, RGB, and HSL, along with the
+color of text which contrasts best with it, black or white.  Colors may
+be given in any of the forms:
+
+   #ff8800, ff8800, or #f80
+   rgb(255, 136, 0)
+   hsl(32, 100%, 50%)
+
+When the output is a terminal a swatch of the color is shown too, using
+truecolor escape sequences, which most modern terminals support.
+
+The '-lighten' and '-darken' flags adjust the lightness of the color by
+the given percentage, for example '-lighten 10' changes a lightness of
+50% to 60%.
+
+Examples:
+
+$ sysbox color '#ff8800'
+$ sysbox color 'rgb(18, 52, 86)'
+$ sysbox color -darken 15 'hsl(210, 65%, 20%)'`
+}
+
+// parse returns the red, green, and blue components of the given color.
+func (c *colorCommand) parse(spec string) (int, int, int, error) {
+
+	spec = strings.ToLower(strings.TrimSpace(spec))
+
+	if m := colorFunction.FindStringSubmatch(spec); m != nil {
+		var values []float64
+		for _, s := range m[2:] {
+			v, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return 0, 0, 0, fmt.Errorf("invalid color '%s'", spec)
+			}
+			values = append(values, v)
+		}
+
+		if m[1] == "rgb" {
+			for _, v := range values {
+				if v > 255 {
+					return 0, 0, 0, fmt.Errorf("RGB values must be between 0 and 255")
+				}
+			}
+			return int(math.Round(values[0])), int(math.Round(values[1])), int(math.Round(values[2])), nil
+		}
+
+		if values[1] > 100 || values[2] > 100 {
+			return 0, 0, 0, fmt.Errorf("saturation and lightness must be between 0%% and 100%%")
+		}
+		r, g, b := c.hslToRGB(math.Mod(values[0], 360), values[1]/100, values[2]/100)
+		return r, g, b, nil
+	}
+
+	hex := strings.TrimPrefix(spec, "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) == 6 {
+		if v, err := strconv.ParseUint(hex, 16, 32); err == nil {
+			return int(v >> 16), int(v >> 8 & 0xff), int(v & 0xff), nil
+		}
+	}
+
+	return 0, 0, 0, fmt.Errorf("invalid color '%s'", spec)
+}
+
+// rgbToHSL converts a color to hue, in degrees, and saturation and
+// lightness, as fractions.
+func (c *colorCommand) rgbToHSL(r, g, b int) (float64, float64, float64) {
+
+	rf, gf, bf := float64(r)/255, float64(g)/255, float64(b)/255
+	highest := math.Max(rf, math.Max(gf, bf))
+	lowest := math.Min(rf, math.Min(gf, bf))
+
+	l := (highest + lowest) / 2
+	if highest == lowest {
+		return 0, 0, l
+	}
+
+	d := highest - lowest
+	s := d / (1 - math.Abs(2*l-1))
+
+	var h float64
+	switch highest {
+	case rf:
+		h = math.Mod((gf-bf)/d, 6)
+	case gf:
+		h = (bf-rf)/d + 2
+	default:
+		h = (rf-gf)/d + 4
+	}
+	h *= 60
+	if h < 0 {
+		h += 360
+	}
+	return h, s, l
+}
+
+// hslToRGB converts a color from hue, saturation, and lightness.
+func (c *colorCommand) hslToRGB(h, s, l float64) (int, int, int) {
+
+	chroma := (1 - math.Abs(2*l-1)) * s
+	x := chroma * (1 - math.Abs(math.Mod(h/60, 2)-1))
+	m := l - chroma/2
+
+	var rf, gf, bf float64
+	switch {
+	case h < 60:
+		rf, gf, bf = chroma, x, 0
+	case h < 120:
+		rf, gf, bf = x, chroma, 0
+	case h < 180:
+		rf, gf, bf = 0, chroma, x
+	case h < 240:
+		rf, gf, bf = 0, x, chroma
+	case h < 300:
+		rf, gf, bf = x, 0, chroma
+	default:
+		rf, gf, bf = chroma, 0, x
+	}
+
+	round := func(v float64) int {
+		return int(math.Round((v + m) * 255))
+	}
+	return round(rf), round(gf), round(bf)
+}
+
+// contrast returns the text color, black or white, which contrasts best
+// with the given color, using the WCAG definition of luminance.
+func (c *colorCommand) contrast(r, g, b int) (int, int, int) {
+
+	linear := func(v int) float64 {
+		f := float64(v) / 255
+		if f <= 0.03928 {
+			return f / 12.92
+		}
+		return math.Pow((f+0.055)/1.055, 2.4)
+	}
+
+	luminance := 0.2126*linear(r) + 0.7152*linear(g) + 0.0722*linear(b)
+	if luminance > 0.179 {
+		return 0, 0, 0
+	}
+	return 255, 255, 255
+}
+
+// Execute is invoked if the user specifies `color` as the subcommand.
+func (c *colorCommand) Execute(args []string) int {
+
+	if len(args) < 1 {
+		fmt.Printf("Usage: color [-lighten N] [-darken N] color\n")
+		return 1
+	}
+
+	r, g, b, err := c.parse(strings.Join(args, " "))
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+
+	if c.lighten != 0 || c.darken != 0 {
+		h, s, l := c.rgbToHSL(r, g, b)
+		l = math.Max(0, math.Min(1, l+(c.lighten-c.darken)/100))
+		r, g, b = c.hslToRGB(h, s, l)
+	}
+
+	h, s, l := c.rgbToHSL(r, g, b)
+	hex := fmt.Sprintf("#%02x%02x%02x", r, g, b)
+	cr, cg, cb := c.contrast(r, g, b)
+
+	fmt.Printf("hex:      %s\n", hex)
+	fmt.Printf("rgb:      rgb(%d, %d, %d)\n", r, g, b)
+	fmt.Printf("hsl:      hsl(%.0f, %.0f%%, %.0f%%)\n", h, s*100, l*100)
+	fmt.Printf("contrast: #%02x%02x%02x\n", cr, cg, cb)
+
+	if terminal.IsTerminal(int(os.Stdout.Fd())) {
+		fmt.Printf("swatch:   \033[48;2;%d;%d;%dm\033[38;2;%d;%d;%dm  %s  \033[0m\n", r, g, b, cr, cg, cb, hex)
+	}
+
+	return 0
+}
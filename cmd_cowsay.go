@@ -0,0 +1,205 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Structure for our options and state.
+type cowsayCommand struct {
+
+	// The name of the figure to draw.
+	figure string
+
+	// Draw a thought bubble, rather than a speech bubble?
+	think bool
+
+	// The width at which to wrap the message.
+	width int
+}
+
+// cowsayFigures are the figures we can draw, with "{{tail}}" being
+// replaced by the lines leading to the bubble.
+var cowsayFigures = map[string]string{
+	"cow": `        {{tail}}   ^__^
+         {{tail}}  (oo)\_______
+            (__)\       )\/\
+                ||----w |
+                ||     ||
+`,
+	"tux": `   {{tail}}
+    {{tail}}
+        .--.
+       |o_o |
+       |:_/ |
+      //   \ \
+     (|     | )
+    /'\_   _/` + "`" + `\
+    \___)=(___/
+`,
+	"kitty": `     {{tail}}
+      {{tail}}
+       /\_/\
+      ( o.o )
+       > ^ <
+`,
+	"sheep": `  {{tail}}
+   {{tail}}
+       __
+      UooU\.'@@@@@@` + "`" + `.
+      \__/(@@@@@@@@@@)
+           (@@@@@@@@)
+           ` + "`" + `YY~~~~YY'
+            ||    ||
+`,
+}
+
+// Arguments adds per-command args to the object.
+func (c *cowsayCommand) Arguments(f *flag.FlagSet) {
+	f.StringVar(&c.figure, "f", "cow", "The figure to draw, one of "+strings.Join(c.figures(), ", "))
+	f.BoolVar(&c.think, "think", false, "Draw a thought bubble, rather than a speech bubble")
+	f.IntVar(&c.width, "W", 40, "The width at which to wrap the message")
+}
+
+// Info returns the name of this subcommand.
+func (c *cowsayCommand) Info() (string, string) {
+	return "cowsay", `Show a message spoken by an ASCII cow.
+
+Details:
+
+This command draws the given message in a speech bubble, above an ASCII
+cow.  The message is taken from the arguments, or read from STDIN if
+there are none.
+
+The message is wrapped at 40 characters, or the width given via '-W',
+and the '-think' flag draws a thought bubble instead.  Other figures may
+be chosen via '-f', one of:
+
+   ` + strings.Join(c.figures(), ", ") + `
+
+Examples:
+
+$ sysbox cowsay Build passed
+$ fortune | sysbox cowsay -think -f tux`
+}
+
+// figures returns the names of the figures we can draw, sorted.
+func (c *cowsayCommand) figures() []string {
+
+	var names []string
+	for name := range cowsayFigures {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// wrap splits the message into lines no wider than the given width.
+func (c *cowsayCommand) wrap(message string) []string {
+
+	var lines []string
+
+	message = strings.Replace(message, "\t", "        ", -1)
+	for _, paragraph := range strings.Split(message, "\n") {
+
+		line := ""
+		for _, word := range strings.Fields(paragraph) {
+
+			// Break words which are too long to fit on a line.
+			for utf8.RuneCountInString(word) > c.width {
+				if line != "" {
+					lines = append(lines, line)
+					line = ""
+				}
+				runes := []rune(word)
+				lines = append(lines, string(runes[:c.width]))
+				word = string(runes[c.width:])
+			}
+
+			switch {
+			case line == "":
+				line = word
+			case utf8.RuneCountInString(line)+1+utf8.RuneCountInString(word) <= c.width:
+				line += " " + word
+			default:
+				lines = append(lines, line)
+				line = word
+			}
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// bubble returns the speech, or thought, bubble containing the lines.
+func (c *cowsayCommand) bubble(lines []string) string {
+
+	width := 0
+	for _, line := range lines {
+		if l := utf8.RuneCountInString(line); l > width {
+			width = l
+		}
+	}
+
+	var out strings.Builder
+	out.WriteString(" " + strings.Repeat("_", width+2) + "\n")
+
+	for i, line := range lines {
+		left, right := "|", "|"
+		switch {
+		case c.think:
+			left, right = "(", ")"
+		case len(lines) == 1:
+			left, right = "<", ">"
+		case i == 0:
+			left, right = "/", "\\"
+		case i == len(lines)-1:
+			left, right = "\\", "/"
+		}
+
+		padding := strings.Repeat(" ", width-utf8.RuneCountInString(line))
+		out.WriteString(left + " " + line + padding + " " + right + "\n")
+	}
+
+	out.WriteString(" " + strings.Repeat("-", width+2) + "\n")
+	return out.String()
+}
+
+// Execute is invoked if the user specifies `cowsay` as the subcommand.
+func (c *cowsayCommand) Execute(args []string) int {
+
+	figure, ok := cowsayFigures[c.figure]
+	if !ok {
+		fmt.Printf("error: unknown figure '%s', choose one of %s\n", c.figure, strings.Join(c.figures(), ", "))
+		return 1
+	}
+	if c.width < 1 {
+		fmt.Printf("error: -W must be at least 1\n")
+		return 1
+	}
+
+	message := strings.Join(args, " ")
+	if len(args) == 0 {
+		data, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			fmt.Printf("error reading STDIN: %s\n", err.Error())
+			return 1
+		}
+		message = strings.TrimRight(string(data), "\n")
+	}
+
+	tail := "\\"
+	if c.think {
+		tail = "o"
+	}
+
+	fmt.Print(c.bubble(c.wrap(message)))
+	fmt.Print(strings.Replace(figure, "{{tail}}", tail, -1))
+
+	return 0
+}
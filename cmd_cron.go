@@ -0,0 +1,434 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Structure for our options and state.
+type cronCommand struct {
+
+	// The number of run times to show.
+	count int
+
+	// The name of the timezone to use, rather than the local one.
+	tz string
+
+	// Describe the expression, rather than showing run times?
+	describe bool
+}
+
+// cronField describes one of the fields of a cron expression.
+type cronField struct {
+
+	// The name of the field, for messages.
+	name string
+
+	// The smallest and largest values the field may hold.
+	min int
+	max int
+
+	// The names which may be used instead of numbers, indexed from min.
+	names []string
+}
+
+// cronFields are the fields of a cron expression, in order.
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day-of-month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12,
+		names: []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}},
+	{name: "day-of-week", min: 0, max: 7,
+		names: []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}},
+}
+
+// cronMacros are the shorthand expressions we understand.
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// cronSchedule is a parsed cron expression.
+type cronSchedule struct {
+
+	// The text of each field, after any macro has been expanded.
+	text []string
+
+	// The values each field matches, as a bitmask.
+	bits []uint64
+}
+
+// Arguments adds per-command args to the object.
+func (c *cronCommand) Arguments(f *flag.FlagSet) {
+	f.IntVar(&c.count, "n", 5, "The number of run times to show")
+	f.StringVar(&c.tz, "tz", "", "The timezone to use, such as 'Europe/Helsinki', rather than the local one")
+	f.BoolVar(&c.describe, "describe", false, "Describe the expression in English, rather than showing run times")
+}
+
+// Info returns the name of this subcommand.
+func (c *cronCommand) Info() (string, string) {
+	return "cron", `Show when a cron expression will next run.
+
+Details:
+
+This command parses a standard five-field cron expression, of the form
+'minute hour day-of-month month day-of-week', and shows the next times
+it will run.  The expression may be given as a single argument, or as
+five separate ones.
+
+Fields may contain '*', numbers, ranges such as '1-5', lists such as
+'1,15', and steps such as '*/15'.  Months and days of the week may be
+given by name, such as 'jan' or 'mon'.  As with cron itself, if both the
+day-of-month and day-of-week are restricted then a day matching either
+of them will run.
+
+The macros @yearly, @annually, @monthly, @weekly, @daily, @midnight, and
+@hourly are understood too.
+
+Times are shown in the local timezone, or the one given via '-tz'.
+
+The '-describe' flag shows the expression in English instead, which is
+useful for checking crontab entries.
+
+Examples:
+
+$ sysbox cron '30 2 * * *'
+$ sysbox cron -n 10 -tz UTC '*/15 9-17 * * mon-fri'
+$ sysbox cron -describe @weekly`
+}
+
+// value parses a single value of the given field, which may be a name.
+func (c *cronCommand) value(field cronField, input string) (int, error) {
+
+	for i, name := range field.names {
+		if strings.EqualFold(input, name) {
+			return field.min + i, nil
+		}
+	}
+
+	n, err := strconv.Atoi(input)
+	if err != nil {
+		return 0, fmt.Errorf("'%s' is not a number", input)
+	}
+	if n < field.min || n > field.max {
+		return 0, fmt.Errorf("%d is out of range %d-%d", n, field.min, field.max)
+	}
+	return n, nil
+}
+
+// item parses a single entry of a list, returning the first and last
+// values it covers, and the step between them.
+func (c *cronCommand) item(field cronField, item string) (int, int, int, error) {
+
+	step := 1
+	hasStep := false
+	if i := strings.Index(item, "/"); i >= 0 {
+		n, err := strconv.Atoi(item[i+1:])
+		if err != nil || n < 1 {
+			return 0, 0, 0, fmt.Errorf("invalid step '%s'", item[i+1:])
+		}
+		step, hasStep = n, true
+		item = item[:i]
+	}
+
+	if item == "*" {
+		return field.min, field.max, step, nil
+	}
+
+	if i := strings.Index(item, "-"); i >= 0 {
+		lo, err := c.value(field, item[:i])
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		hi, err := c.value(field, item[i+1:])
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		if lo > hi {
+			return 0, 0, 0, fmt.Errorf("range %s is backwards", item)
+		}
+		return lo, hi, step, nil
+	}
+
+	n, err := c.value(field, item)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	// As with cron, "5/15" means every fifteenth value from five.
+	if hasStep {
+		return n, field.max, step, nil
+	}
+	return n, n, step, nil
+}
+
+// parse parses a cron expression, or macro.
+func (c *cronCommand) parse(expr string) (*cronSchedule, error) {
+
+	expr = strings.TrimSpace(expr)
+	if strings.HasPrefix(expr, "@") {
+		expanded, ok := cronMacros[strings.ToLower(expr)]
+		if !ok {
+			return nil, fmt.Errorf("unknown macro '%s'", expr)
+		}
+		expr = expanded
+	}
+
+	text := strings.Fields(expr)
+	if len(text) != len(cronFields) {
+		return nil, fmt.Errorf("expected %d fields, found %d", len(cronFields), len(text))
+	}
+
+	s := &cronSchedule{text: text}
+
+	for i, field := range cronFields {
+		bits := uint64(0)
+		for _, entry := range strings.Split(text[i], ",") {
+			lo, hi, step, err := c.item(field, entry)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s field '%s': %s", field.name, text[i], err.Error())
+			}
+			for v := lo; v <= hi; v += step {
+				bits |= 1 << uint(v)
+			}
+		}
+
+		// Sunday may be written as either zero or seven.
+		if field.name == "day-of-week" && bits&(1<<7) != 0 {
+			bits = bits&^(1<<7) | 1
+		}
+		s.bits = append(s.bits, bits)
+	}
+
+	return s, nil
+}
+
+// has returns true if the given field of the schedule matches the value.
+func (s *cronSchedule) has(field int, value int) bool {
+	return s.bits[field]&(1<<uint(value)) != 0
+}
+
+// day returns true if the schedule runs on the given day.
+func (s *cronSchedule) day(t time.Time) bool {
+
+	dom := s.has(2, t.Day())
+	dow := s.has(4, int(t.Weekday()))
+
+	// If both fields are restricted then either may match.
+	if !strings.HasPrefix(s.text[2], "*") && !strings.HasPrefix(s.text[4], "*") {
+		return dom || dow
+	}
+	return dom && dow
+}
+
+// next returns the first time the schedule runs after the given time.
+func (s *cronSchedule) next(after time.Time) (time.Time, error) {
+
+	loc := after.Location()
+	t := after.Truncate(time.Minute).Add(time.Minute)
+
+	// Some schedules, such as February 30th, never run.
+	limit := t.Year() + 50
+
+	for t.Year() < limit {
+		if !s.has(3, int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !s.day(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !s.has(1, t.Hour()) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+		if !s.has(0, t.Minute()) {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t, nil
+	}
+
+	return time.Time{}, fmt.Errorf("the expression never runs")
+}
+
+// name returns the English name of a value of the given field.
+func (c *cronCommand) name(field cronField, value int) string {
+
+	switch field.name {
+	case "month":
+		return time.Month(value).String()
+	case "day-of-week":
+		return time.Weekday(value % 7).String()
+	}
+	return strconv.Itoa(value)
+}
+
+// list joins words as an English list.
+func (c *cronCommand) list(words []string) string {
+
+	if len(words) < 2 {
+		return strings.Join(words, "")
+	}
+	return strings.Join(words[:len(words)-1], ", ") + " and " + words[len(words)-1]
+}
+
+// values describes the values of a field, which has already been parsed.
+func (c *cronCommand) values(field cronField, text string) string {
+
+	var words []string
+	for _, entry := range strings.Split(text, ",") {
+		lo, hi, step, _ := c.item(field, entry)
+
+		word := c.name(field, lo)
+		if hi != lo {
+			word += " through " + c.name(field, hi)
+		}
+		if step != 1 {
+			word += fmt.Sprintf(" (every %d)", step)
+		}
+		words = append(words, word)
+	}
+	return c.list(words)
+}
+
+// every returns the step of fields such as "*/15", or zero.
+func (c *cronCommand) every(text string) int {
+
+	if !strings.HasPrefix(text, "*/") {
+		return 0
+	}
+	n, _ := strconv.Atoi(text[2:])
+	return n
+}
+
+// explain describes a schedule in English.
+func (c *cronCommand) explain(s *cronSchedule) string {
+
+	minute, hour, dom, month, dow := s.text[0], s.text[1], s.text[2], s.text[3], s.text[4]
+
+	var out string
+
+	// Specific times, such as "At 02:30", are the most readable.
+	_, err := strconv.Atoi(minute)
+	if err == nil && !strings.ContainsAny(hour, "*-/") {
+		m, _ := c.value(cronFields[0], minute)
+		var times []string
+		for _, entry := range strings.Split(hour, ",") {
+			h, _ := c.value(cronFields[1], entry)
+			times = append(times, fmt.Sprintf("%02d:%02d", h, m))
+		}
+		out = "At " + c.list(times)
+	} else {
+		switch {
+		case minute == "*":
+			out = "Every minute"
+		case c.every(minute) > 0:
+			out = fmt.Sprintf("Every %d minutes", c.every(minute))
+		default:
+			out = "At minute " + c.values(cronFields[0], minute)
+		}
+
+		switch {
+		case hour == "*":
+			if minute != "*" && c.every(minute) == 0 {
+				out += " past every hour"
+			}
+		case c.every(hour) > 0:
+			out += fmt.Sprintf(" past every %d hours", c.every(hour))
+		default:
+			out += " past hour " + c.values(cronFields[1], hour)
+		}
+	}
+
+	// A day-of-week, or day-of-month, starting with "*" is unrestricted,
+	// so the other field must match too.
+	domAny, dowAny := strings.HasPrefix(dom, "*"), strings.HasPrefix(dow, "*")
+
+	switch {
+	case dom == "*" && dow == "*":
+		if strings.HasPrefix(out, "At ") && !strings.HasPrefix(out, "At minute") {
+			out += " every day"
+		}
+	case !domAny && !dowAny:
+		out += " on day-of-month " + c.values(cronFields[2], dom) + ", or on " + c.values(cronFields[4], dow)
+	case !domAny:
+		out += " on day-of-month " + c.values(cronFields[2], dom)
+		if dow != "*" {
+			out += ", if it is " + c.values(cronFields[4], dow)
+		}
+	case !dowAny:
+		out += " on " + c.values(cronFields[4], dow)
+		if dom != "*" {
+			out += ", if the day-of-month is " + c.values(cronFields[2], dom)
+		}
+	default:
+		if dom != "*" {
+			out += " on day-of-month " + c.values(cronFields[2], dom)
+		}
+		if dow != "*" {
+			out += " on " + c.values(cronFields[4], dow)
+		}
+	}
+
+	switch {
+	case month == "*":
+	case c.every(month) > 0:
+		out += fmt.Sprintf(", every %d months", c.every(month))
+	default:
+		out += " in " + c.values(cronFields[3], month)
+	}
+
+	return out
+}
+
+// Execute is invoked if the user specifies `cron` as the subcommand.
+func (c *cronCommand) Execute(args []string) int {
+
+	if len(args) < 1 {
+		fmt.Printf("Usage: cron [-n count] [-tz zone] [-describe] expression\n")
+		return 1
+	}
+
+	s, err := c.parse(strings.Join(args, " "))
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+
+	if c.describe {
+		fmt.Println(c.explain(s))
+		return 0
+	}
+
+	loc := time.Local
+	if c.tz != "" {
+		loc, err = time.LoadLocation(c.tz)
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			return 1
+		}
+	}
+
+	t := time.Now().In(loc)
+	for i := 0; i < c.count; i++ {
+		t, err = s.next(t)
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			return 1
+		}
+		fmt.Println(t.Format("Mon 2006-01-02 15:04 MST"))
+	}
+
+	return 0
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"testing"
+)
+
+// TestCronDescribe tests describing expressions in English.
+func TestCronDescribe(t *testing.T) {
+
+	tests := []struct {
+		expr     string
+		expected string
+	}{
+		{"* * * * *", "Every minute"},
+		{"*/5 * * * *", "Every 5 minutes"},
+		{"5,10 * * * *", "At minute 5 and 10 past every hour"},
+		{"0-30 * * * *", "At minute 0 through 30 past every hour"},
+		{"@hourly", "At minute 0 past every hour"},
+		{"5 */2 * * *", "At minute 5 past every 2 hours"},
+		{"30 2 * * *", "At 02:30 every day"},
+		{"0 0 * * 0", "At 00:00 on Sunday"},
+		{"0 9 * 1,7 *", "At 09:00 every day in January and July"},
+		{"*/15 9-17 * * 1-5", "Every 15 minutes past hour 9 through 17 on Monday through Friday"},
+		{"0 0 1,15 * 5", "At 00:00 on day-of-month 1 and 15, or on Friday"},
+	}
+
+	c := &cronCommand{}
+
+	for _, test := range tests {
+		s, err := c.parse(test.expr)
+		if err != nil {
+			t.Fatalf("unexpected error parsing '%s': %s", test.expr, err)
+		}
+		if out := c.explain(s); out != test.expected {
+			t.Fatalf("'%s' was described as '%s', expected '%s'", test.expr, out, test.expected)
+		}
+	}
+}
+
+// TestCronInvalid tests that invalid expressions are rejected.
+func TestCronInvalid(t *testing.T) {
+
+	tests := []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"@never",
+	}
+
+	c := &cronCommand{}
+
+	for _, test := range tests {
+		if _, err := c.parse(test); err == nil {
+			t.Fatalf("expected an error parsing '%s'", test)
+		}
+	}
+}
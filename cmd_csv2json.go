@@ -0,0 +1,357 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"unicode/utf8"
+)
+
+// Structure for our options and state.
+type csv2jsonCommand struct {
+
+	// The field delimiter.
+	delimiter string
+
+	// The input has no header row?
+	noHeader bool
+
+	// Output one JSON value per line?
+	ndjson bool
+
+	// Convert JSON to CSV, rather than CSV to JSON?
+	reverse bool
+}
+
+// orderedObject is a JSON object which remembers the order of its keys.
+type orderedObject struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+// Arguments adds per-command args to the object.
+func (c *csv2jsonCommand) Arguments(f *flag.FlagSet) {
+	f.StringVar(&c.delimiter, "d", ",", "The field delimiter")
+	f.BoolVar(&c.noHeader, "no-header", false, "The CSV has no header row, so output arrays rather than objects")
+	f.BoolVar(&c.ndjson, "ndjson", false, "Output newline-delimited JSON, with one record per line")
+	f.BoolVar(&c.reverse, "r", false, "Convert JSON to CSV, rather than CSV to JSON")
+}
+
+// Info returns the name of this subcommand.
+func (c *csv2jsonCommand) Info() (string, string) {
+	return "csv2json", `Convert CSV to JSON, or JSON to CSV.
+
+Details:
+
+This command reads CSV from the named file, or STDIN if no file is given,
+and outputs it as a JSON array.  The first row is taken to be a header,
+and each subsequent row becomes an object with those keys.  With
+'-no-header' each row becomes an array instead.
+
+The '-ndjson' flag outputs each record upon its own line, rather than as
+a single array, which allows the output to be streamed.  The delimiter may
+be changed via '-d', for example '-d ";"', or '-d "\t"' for tabs.
+
+Quoted fields, including those containing delimiters or newlines, are
+handled correctly.
+
+With '-r' the conversion is reversed, and a JSON array of objects (or
+newline-delimited objects) is converted to CSV.  The header row contains
+every key found, and nested values are flattened, so that '{"a":{"b":1}}'
+has the column 'a.b'.
+
+Examples:
+
+$ sysbox csv2json users.csv | jq '.[].email'
+$ sysbox csv2json -d ';' -ndjson export.csv
+$ curl -s https://example.com/api/users | sysbox csv2json -r > users.csv`
+}
+
+// delim returns the delimiter as a rune.
+func (c *csv2jsonCommand) delim() (rune, error) {
+
+	d := c.delimiter
+	if d == `\t` {
+		d = "\t"
+	}
+
+	r, size := utf8.DecodeRuneInString(d)
+	if size == 0 || size != len(d) || r == '"' || r == '\r' || r == '\n' {
+		return 0, fmt.Errorf("invalid delimiter '%s'", c.delimiter)
+	}
+	return r, nil
+}
+
+// toJSON converts the CSV from the reader into JSON.
+func (c *csv2jsonCommand) toJSON(in io.Reader, out *bufio.Writer) error {
+
+	delim, err := c.delim()
+	if err != nil {
+		return err
+	}
+
+	reader := csv.NewReader(in)
+	reader.Comma = delim
+	reader.ReuseRecord = true
+	if c.noHeader {
+		reader.FieldsPerRecord = -1
+	}
+
+	var header []string
+	count := 0
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if header == nil && !c.noHeader {
+			header = append([]string{}, record...)
+			continue
+		}
+
+		var value bytes.Buffer
+		if c.noHeader {
+			data, _ := json.Marshal(record)
+			value.Write(data)
+		} else {
+			value.WriteString("{")
+			for i, field := range record {
+				if i > 0 {
+					value.WriteString(",")
+				}
+				key, _ := json.Marshal(header[i])
+				data, _ := json.Marshal(field)
+				value.Write(key)
+				value.WriteString(":")
+				value.Write(data)
+			}
+			value.WriteString("}")
+		}
+
+		switch {
+		case c.ndjson:
+			out.Write(value.Bytes())
+			out.WriteString("\n")
+		case count == 0:
+			out.WriteString("[\n  ")
+			out.Write(value.Bytes())
+		default:
+			out.WriteString(",\n  ")
+			out.Write(value.Bytes())
+		}
+		count++
+	}
+
+	if !c.ndjson {
+		if count == 0 {
+			out.WriteString("[]\n")
+		} else {
+			out.WriteString("\n]\n")
+		}
+	}
+	return nil
+}
+
+// decodeOrdered reads a single JSON value, preserving the order of the keys
+// of any objects.
+func decodeOrdered(dec *json.Decoder) (interface{}, error) {
+
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	delim, ok := tok.(json.Delim)
+	if !ok {
+		return tok, nil
+	}
+
+	if delim == '[' {
+		var array []interface{}
+		for dec.More() {
+			value, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+			array = append(array, value)
+		}
+		_, err := dec.Token()
+		return array, err
+	}
+
+	object := &orderedObject{values: make(map[string]interface{})}
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		value, err := decodeOrdered(dec)
+		if err != nil {
+			return nil, err
+		}
+
+		name := key.(string)
+		if _, ok := object.values[name]; !ok {
+			object.keys = append(object.keys, name)
+		}
+		object.values[name] = value
+	}
+	_, err = dec.Token()
+	return object, err
+}
+
+// flattenRecord adds the given value to the row, using dotted names for nested
+// values, and records any new column names.
+func flattenRecord(prefix string, value interface{}, row map[string]string, columns *[]string, seen map[string]bool) {
+
+	join := func(name string) string {
+		if prefix == "" {
+			return name
+		}
+		return prefix + "." + name
+	}
+
+	switch v := value.(type) {
+	case *orderedObject:
+		for _, key := range v.keys {
+			flattenRecord(join(key), v.values[key], row, columns, seen)
+		}
+		return
+	case []interface{}:
+		for i, item := range v {
+			flattenRecord(join(strconv.Itoa(i)), item, row, columns, seen)
+		}
+		return
+	}
+
+	if !seen[prefix] {
+		seen[prefix] = true
+		*columns = append(*columns, prefix)
+	}
+
+	switch v := value.(type) {
+	case nil:
+		row[prefix] = ""
+	case string:
+		row[prefix] = v
+	case json.Number:
+		row[prefix] = v.String()
+	case bool:
+		row[prefix] = strconv.FormatBool(v)
+	}
+}
+
+// toCSV converts the JSON from the reader into CSV.
+func (c *csv2jsonCommand) toCSV(in io.Reader, out *bufio.Writer) error {
+
+	delim, err := c.delim()
+	if err != nil {
+		return err
+	}
+
+	dec := json.NewDecoder(in)
+	dec.UseNumber()
+
+	// Collect the records, as we need every column name before we
+	// can write the header.
+	var records []interface{}
+	for {
+		value, err := decodeOrdered(dec)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if array, ok := value.([]interface{}); ok {
+			records = append(records, array...)
+		} else {
+			records = append(records, value)
+		}
+	}
+
+	var columns []string
+	seen := make(map[string]bool)
+
+	var rows []map[string]string
+	for _, record := range records {
+		row := make(map[string]string)
+
+		// Arrays are flattened by position, and scalars have a
+		// column of their own.
+		switch record.(type) {
+		case *orderedObject, []interface{}:
+			flattenRecord("", record, row, &columns, seen)
+		default:
+			flattenRecord("value", record, row, &columns, seen)
+		}
+		rows = append(rows, row)
+	}
+
+	writer := csv.NewWriter(out)
+	writer.Comma = delim
+
+	if !c.noHeader {
+		writer.Write(columns)
+	}
+
+	for _, row := range rows {
+		fields := make([]string, len(columns))
+		for i, column := range columns {
+			fields[i] = row[column]
+		}
+		writer.Write(fields)
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// Execute is invoked if the user specifies `csv2json` as the subcommand.
+func (c *csv2jsonCommand) Execute(args []string) int {
+
+	if len(args) > 1 {
+		fmt.Printf("Usage: csv2json [-d delimiter] [-no-header] [-ndjson] [-r] [file]\n")
+		return 1
+	}
+
+	var in io.Reader = os.Stdin
+	if len(args) == 1 && args[0] != "-" {
+		file, err := os.Open(args[0])
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			return 1
+		}
+		defer file.Close()
+		in = file
+	}
+
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+
+	var err error
+	if c.reverse {
+		err = c.toCSV(in, out)
+	} else {
+		err = c.toJSON(in, out)
+	}
+
+	if err != nil {
+		out.Flush()
+		fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Structure for our options and state.
+type cutCommand struct {
+
+	// The fields to select.
+	fields string
+
+	// The characters to select.
+	characters string
+
+	// The delimiter between fields.
+	delimiter string
+
+	// Select everything except the given fields, or characters?
+	complement bool
+
+	// The delimiter to use in our output.
+	outputDelimiter string
+
+	// The parsed selection.
+	ranges []cutRange
+}
+
+// cutRange holds a range of fields, or characters, counting from 1.
+type cutRange struct {
+	from int
+	to   int
+}
+
+// Arguments adds per-command args to the object.
+func (c *cutCommand) Arguments(f *flag.FlagSet) {
+	f.StringVar(&c.fields, "f", "", "The fields to select, e.g. '1,3-5'")
+	f.StringVar(&c.characters, "c", "", "The characters to select, e.g. '1-10'")
+	f.StringVar(&c.delimiter, "d", "\t", "The delimiter between fields")
+	f.BoolVar(&c.complement, "complement", false, "Select everything except the given fields, or characters")
+	f.StringVar(&c.outputDelimiter, "output-delimiter", "", "The delimiter to use between fields in the output, instead of the input delimiter")
+}
+
+// Info returns the name of this subcommand.
+func (c *cutCommand) Info() (string, string) {
+	return "cut", `Select fields, or characters, from each line.
+
+Details:
+
+This command outputs selected parts of each line of the named files, or
+STDIN if none are given, in the same way as the standard 'cut' command.
+
+With '-f' fields are selected, which are separated by TAB characters by
+default, or by the delimiter given via '-d'.  Lines which don't contain
+the delimiter are shown unchanged, and lines which have fewer fields
+than requested show only those which are present.
+
+With '-c' characters are selected instead.  Characters are counted as
+UTF-8 runes, rather than bytes.
+
+In both cases the selection is a comma-separated list of numbers, or
+ranges, counting from 1, such as '1,3-5'.  A range may be left open,
+so '-3' means the first three, and '5-' everything from the fifth
+onwards.  The '-complement' flag selects everything else instead.
+
+The '-output-delimiter' flag sets the delimiter to use between the
+selected parts, which defaults to the input delimiter for fields, and
+nothing for characters.
+
+Examples:
+
+$ sysbox cut -d : -f 1,7 /etc/passwd
+$ sysbox cut -c 1-8 log.txt
+$ sysbox cut -d , -f 2 -complement -output-delimiter ' ' data.csv`
+}
+
+// parseRanges parses a selection such as "1,3-5,7-".
+func (c *cutCommand) parseRanges(spec string) ([]cutRange, error) {
+
+	var ranges []cutRange
+
+	for _, part := range strings.Split(spec, ",") {
+
+		r := cutRange{from: 1, to: -1}
+
+		var err error
+		if i := strings.Index(part, "-"); i >= 0 {
+			if part == "-" {
+				return nil, fmt.Errorf("invalid range '%s'", part)
+			}
+			if i > 0 {
+				r.from, err = strconv.Atoi(part[:i])
+			}
+			if err == nil && i < len(part)-1 {
+				r.to, err = strconv.Atoi(part[i+1:])
+			}
+		} else {
+			r.from, err = strconv.Atoi(part)
+			r.to = r.from
+		}
+
+		if err != nil || r.from < 1 || (r.to != -1 && r.to < r.from) {
+			return nil, fmt.Errorf("invalid range '%s'", part)
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges, nil
+}
+
+// selected returns true if the given position, counting from 1, is
+// to be output.
+func (c *cutCommand) selected(n int) bool {
+
+	for _, r := range c.ranges {
+		if n >= r.from && (r.to == -1 || n <= r.to) {
+			return !c.complement
+		}
+	}
+	return c.complement
+}
+
+// cut returns the selected parts of the given line.
+func (c *cutCommand) cut(line string) string {
+
+	if c.characters != "" {
+		var out []string
+		for i, r := range []rune(line) {
+			if c.selected(i + 1) {
+				out = append(out, string(r))
+			}
+		}
+		return strings.Join(out, c.outputDelimiter)
+	}
+
+	if !strings.Contains(line, c.delimiter) {
+		return line
+	}
+
+	var out []string
+	for i, field := range strings.Split(line, c.delimiter) {
+		if c.selected(i + 1) {
+			out = append(out, field)
+		}
+	}
+	return strings.Join(out, c.outputDelimiter)
+}
+
+// process outputs the selected parts of each line of the given reader.
+func (c *cutCommand) process(in io.Reader, out *bufio.Writer) error {
+
+	reader := bufio.NewReader(in)
+	for {
+		line, err := reader.ReadString('\n')
+		if len(line) > 0 {
+			out.WriteString(c.cut(strings.TrimSuffix(line, "\n")))
+			out.WriteString("\n")
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Execute is invoked if the user specifies `cut` as the subcommand.
+func (c *cutCommand) Execute(args []string) int {
+
+	if (c.fields == "") == (c.characters == "") {
+		fmt.Printf("error: exactly one of -f or -c must be given\n")
+		return 1
+	}
+	if c.delimiter == "" {
+		fmt.Printf("error: the delimiter must not be empty\n")
+		return 1
+	}
+
+	spec := c.fields
+	if c.characters != "" {
+		spec = c.characters
+	} else if c.outputDelimiter == "" {
+		c.outputDelimiter = c.delimiter
+	}
+
+	var err error
+	c.ranges, err = c.parseRanges(spec)
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+
+	// Default to reading STDIN.
+	if len(args) == 0 {
+		args = []string{"-"}
+	}
+
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+
+	ret := 0
+
+	for _, path := range args {
+		if path == "-" {
+			err = c.process(os.Stdin, out)
+		} else {
+			var file *os.File
+			file, err = os.Open(path)
+			if err == nil {
+				err = c.process(file, out)
+				file.Close()
+			}
+		}
+		if err != nil {
+			out.Flush()
+			fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+			ret = 1
+		}
+	}
+
+	return ret
+}
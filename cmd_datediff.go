@@ -0,0 +1,186 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Structure for our options and state.
+type datediffCommand struct {
+
+	// Count the business days between the dates?
+	businessDays bool
+}
+
+// Arguments adds per-command args to the object.
+func (d *datediffCommand) Arguments(f *flag.FlagSet) {
+	f.BoolVar(&d.businessDays, "business-days", false, "Show the number of weekdays between the dates too")
+}
+
+// Info returns the name of this subcommand.
+func (d *datediffCommand) Info() (string, string) {
+	return "datediff", `Show the time between two dates.
+
+Details:
+
+This command shows the time between two dates, in years, months, and
+days, as well as the total number of days, hours, and seconds.  If only
+one date is given then the time between it and now is shown.
+
+Dates may be Unix timestamps, 'now', or in a variety of common formats
+such as RFC3339, '2006-01-02', or '2006-01-02 15:04:05', which are in
+the local timezone unless they say otherwise.
+
+The '-business-days' flag shows the number of weekdays between the dates
+too, counting the first date but not the last, which is useful for
+planning.  Public holidays are not taken into account.
+
+Examples:
+
+$ sysbox datediff 2020-01-01 2021-03-15
+$ sysbox datediff -business-days now 2024-12-25
+$ sysbox datediff 1600000000`
+}
+
+// parse parses a date, timestamp, or "now".
+func (d *datediffCommand) parse(input string) (time.Time, error) {
+
+	if input == "now" {
+		return time.Now(), nil
+	}
+
+	if stamp, err := strconv.ParseInt(input, 10, 64); err == nil {
+		return time.Unix(stamp, 0), nil
+	}
+
+	for _, layout := range epochLayouts {
+		t, err := time.ParseInLocation(layout, input, time.Local)
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("failed to parse date '%s'", input)
+}
+
+// addMonths adds months to a date, with the day being clamped to the
+// end of the resulting month, so that January 31st plus one month is the
+// last day of February.
+func (d *datediffCommand) addMonths(t time.Time, months int) time.Time {
+
+	m := int(t.Month()) - 1 + months
+	year := t.Year() + m/12
+	month := time.Month(m%12 + 1)
+
+	day := t.Day()
+	if last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day(); day > last {
+		day = last
+	}
+	return time.Date(year, month, day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
+
+// calendar returns the difference between two dates in calendar units,
+// where the first date is not after the second.
+func (d *datediffCommand) calendar(from time.Time, to time.Time) []int {
+
+	to = to.In(from.Location())
+
+	// Find the number of whole months, then the remainder.
+	months := (to.Year()-from.Year())*12 + int(to.Month()) - int(from.Month())
+	for months > 0 && d.addMonths(from, months).After(to) {
+		months--
+	}
+	rest := to.Sub(d.addMonths(from, months))
+
+	days := int(rest / (24 * time.Hour))
+	rest -= time.Duration(days) * 24 * time.Hour
+
+	return []int{
+		months / 12,
+		months % 12,
+		days,
+		int(rest / time.Hour),
+		int(rest % time.Hour / time.Minute),
+		int(rest % time.Minute / time.Second),
+	}
+}
+
+// weekdays returns the number of weekdays from the first date, up to but
+// not including the second.
+func (d *datediffCommand) weekdays(from time.Time, to time.Time) int {
+
+	to = to.In(from.Location())
+	day := time.Date(from.Year(), from.Month(), from.Day(), 12, 0, 0, 0, from.Location())
+	end := time.Date(to.Year(), to.Month(), to.Day(), 12, 0, 0, 0, from.Location())
+
+	count := 0
+	for ; day.Before(end); day = day.AddDate(0, 0, 1) {
+		if day.Weekday() != time.Saturday && day.Weekday() != time.Sunday {
+			count++
+		}
+	}
+	return count
+}
+
+// Execute is invoked if the user specifies `datediff` as the subcommand.
+func (d *datediffCommand) Execute(args []string) int {
+
+	if len(args) < 1 || len(args) > 2 {
+		fmt.Printf("Usage: datediff [-business-days] date [date]\n")
+		return 1
+	}
+	if len(args) == 1 {
+		args = append(args, "now")
+	}
+
+	var dates []time.Time
+	for _, arg := range args {
+		t, err := d.parse(arg)
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			return 1
+		}
+		dates = append(dates, t)
+	}
+
+	from, to := dates[0], dates[1]
+	sign, direction := "", ""
+	if to.Before(from) {
+		from, to = to, from
+		sign, direction = "-", "minus "
+	}
+
+	var parts []string
+	names := []string{"year", "month", "day", "hour", "minute", "second"}
+	for i, n := range d.calendar(from, to) {
+		if n == 0 {
+			continue
+		}
+		name := names[i]
+		if n != 1 {
+			name += "s"
+		}
+		parts = append(parts, fmt.Sprintf("%d %s", n, name))
+	}
+	if len(parts) == 0 {
+		parts = []string{"0 seconds"}
+	}
+
+	elapsed := to.Sub(from)
+
+	fmt.Printf("From:     %s\n", dates[0].Format(time.RFC3339))
+	fmt.Printf("To:       %s\n", dates[1].Format(time.RFC3339))
+	fmt.Printf("Duration: %s%s\n", direction, strings.Join(parts, ", "))
+	fmt.Printf("Days:     %s%.2f\n", sign, elapsed.Hours()/24)
+	fmt.Printf("Hours:    %s%.2f\n", sign, elapsed.Hours())
+	fmt.Printf("Seconds:  %s%d\n", sign, int64(elapsed.Seconds()))
+
+	if d.businessDays {
+		fmt.Printf("Weekdays: %s%d\n", sign, d.weekdays(from, to))
+	}
+
+	return 0
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+// Structure for our options and state.
+type dnsCommand struct {
+
+	// The type of record to lookup.
+	recordType string
+
+	// The DNS server to query, if not the system default.
+	server string
+
+	// The maximum time to wait for a response.
+	timeout time.Duration
+}
+
+// Arguments adds per-command args to the object.
+func (d *dnsCommand) Arguments(f *flag.FlagSet) {
+	f.StringVar(&d.recordType, "t", "A", "The type of record to lookup (A, AAAA, MX, TXT, NS, CNAME, or SRV)")
+	f.StringVar(&d.server, "server", "", "The DNS server to query, rather than the system default")
+	f.DurationVar(&d.timeout, "timeout", 10*time.Second, "The maximum time to wait for a response")
+}
+
+// Info returns the name of this subcommand.
+func (d *dnsCommand) Info() (string, string) {
+	return "dns", `Lookup DNS records.
+
+Details:
+
+This command looks up the DNS records of the given name, showing one
+record per line.  By default A records are shown, but '-t' may be used to
+choose AAAA, MX, TXT, NS, CNAME, or SRV records instead.
+
+MX records are shown as 'preference host', and SRV records as
+'priority weight port target'.
+
+The system resolver is used by default, but '-server' allows a specific
+server to be queried instead, optionally including a port.
+
+If the name cannot be resolved a non-zero exit-code is returned.
+
+Examples:
+
+$ sysbox dns steve.fi
+$ sysbox dns -t MX -server 8.8.8.8 gmail.com
+$ sysbox dns -t SRV _xmpp-server._tcp.jabber.org`
+}
+
+// resolver returns the resolver to use for our lookups.
+func (d *dnsCommand) resolver() *net.Resolver {
+
+	if d.server == "" {
+		return net.DefaultResolver
+	}
+
+	// Add the default port, if none was given.
+	server := d.server
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(server, "53")
+	}
+
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, network, server)
+		},
+	}
+}
+
+// lookup returns the records of the given type for the given name.
+func (d *dnsCommand) lookup(ctx context.Context, name string) ([]string, error) {
+
+	resolver := d.resolver()
+
+	var results []string
+
+	switch d.recordType {
+	case "A", "AAAA":
+		addrs, err := resolver.LookupIPAddr(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		for _, addr := range addrs {
+			if (addr.IP.To4() != nil) == (d.recordType == "A") {
+				results = append(results, addr.IP.String())
+			}
+		}
+
+	case "CNAME":
+		cname, err := resolver.LookupCNAME(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, cname)
+
+	case "MX":
+		records, err := resolver.LookupMX(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		for _, mx := range records {
+			results = append(results, fmt.Sprintf("%d %s", mx.Pref, mx.Host))
+		}
+
+	case "NS":
+		records, err := resolver.LookupNS(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		for _, ns := range records {
+			results = append(results, ns.Host)
+		}
+
+	case "SRV":
+		_, records, err := resolver.LookupSRV(ctx, "", "", name)
+		if err != nil {
+			return nil, err
+		}
+		for _, srv := range records {
+			results = append(results, fmt.Sprintf("%d %d %d %s", srv.Priority, srv.Weight, srv.Port, srv.Target))
+		}
+
+	case "TXT":
+		records, err := resolver.LookupTXT(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, records...)
+
+	default:
+		return nil, fmt.Errorf("unknown record type '%s'", d.recordType)
+	}
+
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no %s records found for %s", d.recordType, name)
+	}
+
+	return results, nil
+}
+
+// Execute is invoked if the user specifies `dns` as the subcommand.
+func (d *dnsCommand) Execute(args []string) int {
+
+	if len(args) != 1 {
+		fmt.Printf("Usage: dns [-t TYPE] [-server SERVER] name\n")
+		return 1
+	}
+
+	d.recordType = strings.ToUpper(d.recordType)
+
+	ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
+	defer cancel()
+
+	records, err := d.lookup(ctx, args[0])
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+
+	for _, record := range records {
+		fmt.Println(record)
+	}
+	return 0
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Structure for our options and state.
+type dos2unixCommand struct {
+
+	// Convert LF to CRLF, rather than the reverse?
+	reverse bool
+
+	// Report the line-endings, rather than converting them?
+	detect bool
+}
+
+// errBinary is returned when we refuse to convert binary data.
+var errBinary = errors.New("refusing to convert binary data")
+
+// Arguments adds per-command args to the object.
+func (d *dos2unixCommand) Arguments(f *flag.FlagSet) {
+	f.BoolVar(&d.reverse, "reverse", false, "Convert LF line-endings to CRLF, as unix2dos does")
+	f.BoolVar(&d.detect, "detect", false, "Report the line-endings of each file, rather than converting them")
+}
+
+// Info returns the name of this subcommand.
+func (d *dos2unixCommand) Info() (string, string) {
+	return "dos2unix", `Convert line-endings between DOS and Unix formats.
+
+Details:
+
+This command converts DOS line-endings (CRLF) to Unix line-endings (LF),
+or with '-reverse' converts Unix line-endings to DOS line-endings.
+
+Files named as arguments are converted in place, keeping their
+permissions, otherwise STDIN is converted and written to STDOUT.
+
+Only complete line-endings are converted, so files which contain a mix
+of both styles are converted safely, without adding or removing extra
+carriage returns.  Binary files, those containing NUL bytes, are not
+converted.
+
+The '-detect' flag reports the line-endings each file contains, along
+with whether it is binary, without changing it.
+
+Examples:
+
+$ sysbox dos2unix notes.txt
+$ sysbox dos2unix -reverse < report.csv > report-dos.csv
+$ sysbox dos2unix -detect *.txt`
+}
+
+// convert copies the input to the output, converting line-endings.
+func (d *dos2unixCommand) convert(in io.Reader, out io.Writer) error {
+
+	reader := bufio.NewReaderSize(in, 64*1024)
+	writer := bufio.NewWriter(out)
+
+	// Check the start of the data for NUL bytes.
+	head, err := reader.Peek(8000)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return err
+	}
+	if bytes.IndexByte(head, 0) >= 0 {
+		return errBinary
+	}
+
+	prev := byte(0)
+	for {
+		b, err := reader.ReadByte()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if d.reverse {
+			if b == '\n' && prev != '\r' {
+				writer.WriteByte('\r')
+			}
+		} else if b == '\r' {
+			if next, err := reader.Peek(1); err == nil && next[0] == '\n' {
+				prev = b
+				continue
+			}
+		}
+
+		writer.WriteByte(b)
+		prev = b
+	}
+
+	return writer.Flush()
+}
+
+// convertFile converts the named file in place, via a temporary file.
+func (d *dos2unixCommand) convertFile(path string) error {
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("%s is not a regular file", path)
+	}
+
+	in, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	tmp, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".")
+	if err != nil {
+		return err
+	}
+
+	err = d.convert(in, tmp)
+	if err == nil {
+		err = tmp.Chmod(info.Mode().Perm())
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	if err == errBinary {
+		return fmt.Errorf("%s: %s", path, err.Error())
+	}
+	return err
+}
+
+// report describes the line-endings of the named file.
+func (d *dos2unixCommand) report(path string) (string, error) {
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	if bytes.IndexByte(data, 0) >= 0 {
+		return "binary", nil
+	}
+
+	crlf := bytes.Count(data, []byte("\r\n"))
+	lf := bytes.Count(data, []byte("\n")) - crlf
+	cr := bytes.Count(data, []byte("\r")) - crlf
+
+	var styles []string
+	if crlf > 0 {
+		styles = append(styles, fmt.Sprintf("%d CRLF", crlf))
+	}
+	if lf > 0 {
+		styles = append(styles, fmt.Sprintf("%d LF", lf))
+	}
+	if cr > 0 {
+		styles = append(styles, fmt.Sprintf("%d CR", cr))
+	}
+
+	switch len(styles) {
+	case 0:
+		return "text, no line-endings", nil
+	case 1:
+		return "text, " + styles[0], nil
+	}
+	return fmt.Sprintf("text, mixed (%s)", strings.Join(styles, ", ")), nil
+}
+
+// Execute is invoked if the user specifies `dos2unix` as the subcommand.
+func (d *dos2unixCommand) Execute(args []string) int {
+
+	if len(args) == 0 {
+		if d.detect {
+			fmt.Printf("Usage: dos2unix -detect file [file ...]\n")
+			return 1
+		}
+		if err := d.convert(os.Stdin, os.Stdout); err != nil {
+			fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+			return 1
+		}
+		return 0
+	}
+
+	ret := 0
+
+	for _, path := range args {
+		if d.detect {
+			style, err := d.report(path)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+				ret = 1
+				continue
+			}
+			fmt.Printf("%s: %s\n", path, style)
+			continue
+		}
+
+		if err := d.convertFile(path); err != nil {
+			fmt.Fprintf(os.Stderr, "warning: %s\n", err.Error())
+			ret = 1
+		}
+	}
+
+	return ret
+}
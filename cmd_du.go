@@ -0,0 +1,154 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/dustin/go-humanize"
+)
+
+// Structure for our options and state.
+type duCommand struct {
+
+	// Show sizes in human-readable units?
+	human bool
+
+	// The maximum depth of directories to show, or -1 for no limit.
+	maxDepth int
+
+	// Sort the results by size?
+	sort bool
+
+	// Show only this many of the largest entries.
+	top int
+
+	// The directories we've found, and their sizes.
+	results []duEntry
+
+	// Did we hit an error?
+	failed bool
+}
+
+// duEntry holds the size of a single directory.
+type duEntry struct {
+	path string
+	size int64
+}
+
+// Arguments adds per-command args to the object.
+func (d *duCommand) Arguments(f *flag.FlagSet) {
+	f.BoolVar(&d.human, "h", false, "Show sizes in human-readable units, such as '1.5 MiB'")
+	f.IntVar(&d.maxDepth, "max-depth", -1, "Show only directories this many levels below the arguments")
+	f.BoolVar(&d.sort, "sort", false, "Sort the results by size, largest first")
+	f.IntVar(&d.top, "top", 0, "Show only this many of the largest entries")
+}
+
+// Info returns the name of this subcommand.
+func (d *duCommand) Info() (string, string) {
+	return "du", `Show the disk usage of directories.
+
+Details:
+
+This command shows the total size of the files beneath each directory
+within the named directories, or the current directory if none are
+given.  Sizes are shown in bytes, or in human-readable units via '-h'.
+
+The '-max-depth' flag limits the directories which are shown, though
+the sizes always include everything beneath them, so '-max-depth 0'
+shows only the total for each argument.
+
+The '-sort' flag sorts the results by size, largest first, and '-top N'
+shows only the N largest entries, which is useful for finding out what
+is using up the disk.
+
+Directories which cannot be read are reported, and skipped, and result
+in a non-zero exit-code.
+
+Examples:
+
+$ sysbox du -h -max-depth 1 /var
+$ sysbox du -h -top 10 /home`
+}
+
+// walk returns the size of everything beneath the given path, recording
+// the sizes of directories as it goes.
+func (d *duCommand) walk(path string, info os.FileInfo, depth int) int64 {
+
+	if !info.IsDir() {
+		return info.Size()
+	}
+
+	var total int64
+
+	entries, err := ioutil.ReadDir(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", err.Error())
+		d.failed = true
+	}
+
+	// Symlinks are counted, but not followed, as ReadDir uses lstat.
+	for _, entry := range entries {
+		total += d.walk(filepath.Join(path, entry.Name()), entry, depth+1)
+	}
+
+	if d.maxDepth < 0 || depth <= d.maxDepth {
+		d.results = append(d.results, duEntry{path: path, size: total})
+	}
+	return total
+}
+
+// Execute is invoked if the user specifies `du` as the subcommand.
+func (d *duCommand) Execute(args []string) int {
+
+	if d.top < 0 {
+		fmt.Printf("error: -top must not be negative\n")
+		return 1
+	}
+
+	if len(args) == 0 {
+		args = []string{"."}
+	}
+
+	for _, path := range args {
+		info, err := os.Lstat(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "warning: %s\n", err.Error())
+			d.failed = true
+			continue
+		}
+
+		size := d.walk(path, info, 0)
+
+		// Files given as arguments are shown too.
+		if !info.IsDir() {
+			d.results = append(d.results, duEntry{path: path, size: size})
+		}
+	}
+
+	results := d.results
+	if d.sort || d.top > 0 {
+		sort.SliceStable(results, func(i, j int) bool {
+			return results[i].size > results[j].size
+		})
+	}
+	if d.top > 0 && len(results) > d.top {
+		results = results[:d.top]
+	}
+
+	for _, entry := range results {
+		if d.human {
+			fmt.Printf("%s\t%s\n", humanize.IBytes(uint64(entry.size)), entry.path)
+		} else {
+			fmt.Printf("%d\t%s\n", entry.size, entry.path)
+		}
+	}
+
+	if d.failed {
+		return 1
+	}
+	return 0
+}
@@ -1,20 +1,39 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"strings"
 	"text/template"
 
-	"github.com/skx/subcommands"
+	"gopkg.in/yaml.v3"
 )
 
 // Structure for our options and state.
 type envTemplateCommand struct {
 
-	// We embed the NoFlags option, because we accept no command-line flags.
-	subcommands.NoFlags
+	// A JSON, or YAML, file to load the template data from.
+	data string
+
+	// The template to expand, as an alternative to an argument.
+	template string
+
+	// The file to write the output to, rather than STDOUT.
+	output string
+
+	// Fail if the template refers to a missing key?
+	strict bool
+}
+
+// Arguments adds per-command args to the object.
+func (et *envTemplateCommand) Arguments(f *flag.FlagSet) {
+	f.StringVar(&et.data, "d", "", "Load the template data from the given JSON, or YAML, file")
+	f.StringVar(&et.output, "o", "", "Write the output to the given file, rather than STDOUT")
+	f.StringVar(&et.template, "t", "", "The template to expand, as an alternative to giving it as an argument")
+	f.BoolVar(&et.strict, "strict", false, "Fail if the template refers to a missing key")
 }
 
 // Info returns the name of this subcommand.
@@ -51,25 +70,63 @@ and process variables.  For example splitting $PATH into parts:
       {{$k}} {{$v}}
     {{end}}
 
+The environmental variables are also available as the template data, so
+'{{.USER}}' works as well as '{{env "USER"}}'.  Alternatively '-d' may
+be used to load the data from a JSON, or YAML, file:
+
+    $ sysbox env-template -d config.yaml -o nginx.conf nginx.conf.tmpl
+
+The functions 'upper', 'lower', and 'default' are available too, with
+the latter returning its first argument if the second is empty:
+
+    Hello {{.name | default "world" | upper}}
+
+By default missing keys expand to '<no value>', but with '-strict' they
+result in an error instead.
+
+This command may also be invoked as 'template', and the template to
+expand may be given via '-t' rather than as an argument:
+
+    $ sysbox template -t nginx.conf.tmpl -d config.yaml
 `
 
 }
 
-// Execute is invoked if the user specifies `with-lock` as the subcommand.
+// Execute is invoked if the user specifies `env-template` as the subcommand.
 func (et *envTemplateCommand) Execute(args []string) int {
 
 	//
 	// Ensure we have an argument
 	//
+	if et.template != "" {
+		args = append([]string{et.template}, args...)
+	}
 	if len(args) < 1 {
 		fmt.Printf("You must specify the template to expand\n")
 		return 1
 	}
 
+	data, err := et.loadData()
+	if err != nil {
+		fmt.Printf("error loading data %s\n", err.Error())
+		return 1
+	}
+
+	var out io.Writer = os.Stdout
+	if et.output != "" {
+		file, err := os.Create(et.output)
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			return 1
+		}
+		defer file.Close()
+		out = file
+	}
+
 	fail := 0
 
 	for _, file := range args {
-		err := et.expandFile(file)
+		err := et.expandFile(file, data, out)
 		if err != nil {
 			fmt.Printf("error processing %s %s\n", file, err.Error())
 			fail = 1
@@ -78,8 +135,34 @@ func (et *envTemplateCommand) Execute(args []string) int {
 	return fail
 }
 
+// loadData returns the data to pass to our templates, either the
+// contents of the data-file or the environment.
+func (et *envTemplateCommand) loadData() (interface{}, error) {
+
+	if et.data == "" {
+		env := make(map[string]string)
+		for _, pair := range os.Environ() {
+			kv := strings.SplitN(pair, "=", 2)
+			if len(kv) == 2 {
+				env[kv[0]] = kv[1]
+			}
+		}
+		return env, nil
+	}
+
+	content, err := ioutil.ReadFile(et.data)
+	if err != nil {
+		return nil, err
+	}
+
+	// JSON is a subset of YAML, so we can parse either.
+	var data interface{}
+	err = yaml.Unmarshal(content, &data)
+	return data, err
+}
+
 // expandFile does the file expansion
-func (et *envTemplateCommand) expandFile(path string) error {
+func (et *envTemplateCommand) expandFile(path string, data interface{}, out io.Writer) error {
 
 	// Load the file
 	content, err := ioutil.ReadFile(path)
@@ -98,13 +181,38 @@ func (et *envTemplateCommand) expandFile(path string) error {
 		"split": func(in string, delim string) []string {
 			return strings.Split(in, delim)
 		},
+		"upper": strings.ToUpper,
+		"lower": strings.ToLower,
+		"default": func(def interface{}, value interface{}) interface{} {
+			if value == nil || value == "" {
+				return def
+			}
+			return value
+		},
 	}
 
 	// Parse the file
-	t := template.Must(template.New("t1").Funcs(funcMap).Parse(string(content)))
+	t, err := template.New("t1").Funcs(funcMap).Parse(string(content))
+	if err != nil {
+		return err
+	}
+	if et.strict {
+		t = t.Option("missingkey=error")
+	}
 
 	// Render
-	err = t.Execute(os.Stdout, nil)
+	err = t.Execute(out, data)
 
 	return err
 }
+
+// templateCommand allows env-template to be invoked as template.
+type templateCommand struct {
+	envTemplateCommand
+}
+
+// Info returns the name of this subcommand.
+func (t *templateCommand) Info() (string, string) {
+	_, help := t.envTemplateCommand.Info()
+	return "template", help
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+// Structure for our options and state.
+type envsubstCommand struct {
+
+	// A comma-separated list of the variables to substitute.
+	only string
+
+	// Fail if a referenced variable is unset?
+	failUnset bool
+}
+
+// Arguments adds per-command args to the object.
+func (e *envsubstCommand) Arguments(f *flag.FlagSet) {
+	f.StringVar(&e.only, "only", "", "Substitute only the given variables, e.g. 'HOME,USER'")
+	f.BoolVar(&e.failUnset, "fail-unset", false, "Fail if a referenced variable is unset, rather than substituting an empty string")
+}
+
+// Info returns the name of this subcommand.
+func (e *envsubstCommand) Info() (string, string) {
+	return "envsubst", `Substitute environmental variables in text.
+
+Details:
+
+This command reads the named files, or STDIN if no files are given, and
+replaces references to environmental variables, in the form '$VAR' or
+'${VAR}', with their values.  It works in the same way as the GNU
+'envsubst' tool, which might not be available upon systems by default.
+
+A literal '$' may be produced via '$$'.
+
+The '-only' flag restricts the substitution to the named variables, with
+any other references left untouched.
+
+By default unset variables are replaced with an empty string, but with
+'-fail-unset' an error is reported instead, and nothing is output.
+
+Examples:
+
+$ echo 'Hello $USER, your home is ${HOME}' | sysbox envsubst
+$ sysbox envsubst -only PORT,HOST nginx.conf.in > nginx.conf
+$ sysbox envsubst -fail-unset config.in`
+}
+
+// isNameChar returns true if the character may be used in a variable name,
+// with digits being excluded from the first position.
+func isNameChar(c byte, first bool) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (!first && c >= '0' && c <= '9')
+}
+
+// expand returns the input with variables substituted, along with the names
+// of any referenced variables which were unset.
+func (e *envsubstCommand) expand(input string) (string, []string) {
+
+	allowed := make(map[string]bool)
+	for _, name := range strings.Split(e.only, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			allowed[strings.TrimPrefix(name, "$")] = true
+		}
+	}
+
+	var out strings.Builder
+	var unset []string
+	seen := make(map[string]bool)
+
+	for i := 0; i < len(input); i++ {
+		c := input[i]
+		if c != '$' || i+1 >= len(input) {
+			out.WriteByte(c)
+			continue
+		}
+
+		// "$$" is a literal dollar.
+		if input[i+1] == '$' {
+			out.WriteByte('$')
+			i++
+			continue
+		}
+
+		// Find the name, and the end of the reference.
+		name := ""
+		end := i
+		if input[i+1] == '{' {
+			length := strings.IndexByte(input[i+2:], '}')
+			if length > 0 {
+				name = input[i+2 : i+2+length]
+				end = i + 2 + length
+			}
+		} else {
+			j := i + 1
+			for j < len(input) && isNameChar(input[j], j == i+1) {
+				j++
+			}
+			name = input[i+1 : j]
+			end = j - 1
+		}
+
+		// Not a valid reference, or not one we're substituting.
+		valid := name != ""
+		for k := 0; k < len(name); k++ {
+			if !isNameChar(name[k], k == 0) {
+				valid = false
+			}
+		}
+		if !valid || (len(allowed) > 0 && !allowed[name]) {
+			out.WriteByte(c)
+			continue
+		}
+
+		value, ok := os.LookupEnv(name)
+		if !ok && !seen[name] {
+			unset = append(unset, name)
+			seen[name] = true
+		}
+		out.WriteString(value)
+		i = end
+	}
+
+	return out.String(), unset
+}
+
+// Execute is invoked if the user specifies `envsubst` as the subcommand.
+func (e *envsubstCommand) Execute(args []string) int {
+
+	// Default to reading STDIN.
+	if len(args) == 0 {
+		args = []string{"-"}
+	}
+
+	ret := 0
+
+	for _, path := range args {
+
+		var data []byte
+		var err error
+		if path == "-" {
+			data, err = ioutil.ReadAll(os.Stdin)
+		} else {
+			data, err = ioutil.ReadFile(path)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+			ret = 1
+			continue
+		}
+
+		output, unset := e.expand(string(data))
+		if e.failUnset && len(unset) > 0 {
+			fmt.Fprintf(os.Stderr, "error: %s: unset variables: %s\n", path, strings.Join(unset, ", "))
+			ret = 1
+			continue
+		}
+
+		fmt.Print(output)
+	}
+
+	return ret
+}
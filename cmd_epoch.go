@@ -0,0 +1,163 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Structure for our options and state.
+type epochCommand struct {
+
+	// Are timestamps in milliseconds?
+	ms bool
+
+	// The layout to use for output, and input, of dates.
+	format string
+
+	// The name of the timezone to use, rather than the local one.
+	tz string
+}
+
+// epochLayouts are the layouts we try when parsing a date.
+var epochLayouts = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+	time.RFC1123Z,
+	time.RFC1123,
+	time.RFC850,
+	time.UnixDate,
+	time.ANSIC,
+}
+
+// Arguments adds per-command args to the object.
+func (e *epochCommand) Arguments(f *flag.FlagSet) {
+	f.BoolVar(&e.ms, "ms", false, "Timestamps are in milliseconds, rather than seconds")
+	f.StringVar(&e.format, "format", "", "The layout to use for dates, in Go's reference format, e.g. '2006-01-02 15:04'")
+	f.StringVar(&e.tz, "tz", "", "The timezone to use, such as 'Europe/Helsinki', rather than the local one")
+}
+
+// Info returns the name of this subcommand.
+func (e *epochCommand) Info() (string, string) {
+	return "epoch", `Convert between Unix timestamps and dates.
+
+Details:
+
+With no arguments this command shows the current Unix timestamp.
+
+If given a timestamp the corresponding date is shown, in both the local
+timezone and UTC.  If given a date the corresponding timestamp is shown.
+Dates may be in a variety of common formats, such as RFC3339, or
+'2006-01-02 15:04:05'.
+
+The '-ms' flag will treat timestamps as milliseconds, rather than seconds.
+
+The '-format' flag allows a custom layout to be used, for both displaying
+and parsing dates.  This uses Go's reference time, so for example the
+layout '02/01/2006 15:04' would be used for '31/12/2020 23:59'.
+
+The '-tz' flag allows a named timezone to be used instead of the local
+one, for example 'America/New_York'.
+
+Examples:
+
+$ sysbox epoch
+$ sysbox epoch 1600000000
+$ sysbox epoch -tz Asia/Tokyo 1600000000
+$ sysbox epoch '2020-09-13 12:26:40'`
+}
+
+// location returns the timezone the user selected.
+func (e *epochCommand) location() (*time.Location, error) {
+	if e.tz == "" {
+		return time.Local, nil
+	}
+	return time.LoadLocation(e.tz)
+}
+
+// show returns the given time in the user's chosen layout.
+func (e *epochCommand) show(t time.Time) string {
+	if e.format != "" {
+		return t.Format(e.format)
+	}
+	return t.Format(time.RFC3339)
+}
+
+// parse parses the given date, in the given location.
+func (e *epochCommand) parse(input string, loc *time.Location) (time.Time, error) {
+
+	layouts := epochLayouts
+	if e.format != "" {
+		layouts = []string{e.format}
+	}
+
+	for _, layout := range layouts {
+		t, err := time.ParseInLocation(layout, input, loc)
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("failed to parse date '%s'", input)
+}
+
+// Execute is invoked if the user specifies `epoch` as the subcommand.
+func (e *epochCommand) Execute(args []string) int {
+
+	loc, err := e.location()
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+
+	// Show the current time.
+	if len(args) == 0 {
+		now := time.Now()
+		if e.ms {
+			fmt.Println(now.UnixNano() / int64(time.Millisecond))
+		} else {
+			fmt.Println(now.Unix())
+		}
+		return 0
+	}
+
+	input := strings.Join(args, " ")
+
+	// A timestamp?
+	if stamp, err := strconv.ParseInt(input, 10, 64); err == nil {
+		var t time.Time
+		if e.ms {
+			t = time.Unix(0, stamp*int64(time.Millisecond))
+		} else {
+			t = time.Unix(stamp, 0)
+		}
+
+		name := "Local"
+		if e.tz != "" {
+			name = e.tz
+		}
+		fmt.Printf("%s: %s\n", name, e.show(t.In(loc)))
+		fmt.Printf("UTC: %s\n", e.show(t.UTC()))
+		return 0
+	}
+
+	// Otherwise a date.
+	t, err := e.parse(input, loc)
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+
+	if e.ms {
+		fmt.Println(t.UnixNano() / int64(time.Millisecond))
+	} else {
+		fmt.Println(t.Unix())
+	}
+	return 0
+}
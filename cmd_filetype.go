@@ -0,0 +1,180 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// Structure for our options and state.
+type filetypeCommand struct {
+
+	// Show only the MIME type?
+	mimeOnly bool
+}
+
+// fileMagic describes a type of file, identified by the bytes at an offset.
+type fileMagic struct {
+	offset      int
+	magic       string
+	mime        string
+	description string
+}
+
+// fileMagics holds the types we recognize, beyond those which
+// http.DetectContentType knows about.
+var fileMagics = []fileMagic{
+	{0, "\x89PNG\r\n\x1a\n", "image/png", "PNG image"},
+	{0, "\xff\xd8\xff", "image/jpeg", "JPEG image"},
+	{0, "GIF87a", "image/gif", "GIF image"},
+	{0, "GIF89a", "image/gif", "GIF image"},
+	{0, "%PDF-", "application/pdf", "PDF document"},
+	{0, "\x7fELF", "application/x-executable", "ELF executable"},
+	{0, "PK\x03\x04", "application/zip", "Zip archive"},
+	{0, "PK\x05\x06", "application/zip", "Zip archive (empty)"},
+	{0, "\x1f\x8b", "application/gzip", "gzip compressed data"},
+	{0, "BZh", "application/x-bzip2", "bzip2 compressed data"},
+	{0, "\xfd7zXZ\x00", "application/x-xz", "XZ compressed data"},
+	{0, "\x28\xb5\x2f\xfd", "application/zstd", "Zstandard compressed data"},
+	{0, "7z\xbc\xaf\x27\x1c", "application/x-7z-compressed", "7-zip archive"},
+	{257, "ustar", "application/x-tar", "tar archive"},
+	{0, "MZ", "application/vnd.microsoft.portable-executable", "DOS/Windows executable"},
+	{0, "\xcf\xfa\xed\xfe", "application/x-mach-binary", "Mach-O 64-bit executable"},
+	{0, "\xce\xfa\xed\xfe", "application/x-mach-binary", "Mach-O 32-bit executable"},
+	{0, "\xca\xfe\xba\xbe", "application/java-vm", "Java class, or Mach-O universal binary"},
+	{0, "SQLite format 3\x00", "application/vnd.sqlite3", "SQLite database"},
+	{0, "\x00asm", "application/wasm", "WebAssembly module"},
+	{0, "OggS", "audio/ogg", "Ogg media"},
+	{0, "fLaC", "audio/flac", "FLAC audio"},
+	{0, "ID3", "audio/mpeg", "MP3 audio"},
+	{4, "ftyp", "video/mp4", "MPEG-4 media"},
+	{0, "\x1a\x45\xdf\xa3", "video/webm", "Matroska/WebM media"},
+	{0, "-----BEGIN ", "application/x-pem-file", "PEM encoded data"},
+	{0, "#!", "text/x-shellscript", "script"},
+}
+
+// Arguments adds per-command args to the object.
+func (f *filetypeCommand) Arguments(fs *flag.FlagSet) {
+	fs.BoolVar(&f.mimeOnly, "mime-only", false, "Show only the MIME type of each file")
+}
+
+// Info returns the name of this subcommand.
+func (f *filetypeCommand) Info() (string, string) {
+	return "filetype", `Identify the type of files from their contents.
+
+Details:
+
+This command reads the start of each named file, and reports its type
+based upon its contents, rather than its name.  The MIME type is shown
+along with a description, for example:
+
+   $ sysbox filetype /bin/ls logo.png
+   /bin/ls: application/x-executable (ELF executable)
+   logo.png: image/png (PNG image)
+
+Many common formats are recognized, including images, archives, compressed
+data, executables, and documents.  Other files are identified in the same
+way as web-browsers do, via Go's http.DetectContentType.
+
+The '-mime-only' flag shows only the MIME type.
+
+Examples:
+
+$ sysbox filetype *
+$ sysbox filetype -mime-only download.bin`
+}
+
+// detect returns the MIME type, and description, of the given data.
+func (f *filetypeCommand) detect(data []byte) (string, string) {
+
+	if len(data) == 0 {
+		return "inode/x-empty", "empty"
+	}
+
+	for _, m := range fileMagics {
+		if len(data) >= m.offset+len(m.magic) && bytes.Equal(data[m.offset:m.offset+len(m.magic)], []byte(m.magic)) {
+
+			// Scripts are described by their interpreter.
+			if m.magic == "#!" {
+				line := string(data)
+				if i := strings.IndexByte(line, '\n'); i >= 0 {
+					line = line[:i]
+				}
+				return m.mime, strings.TrimSpace(line[2:]) + " script"
+			}
+			return m.mime, m.description
+		}
+	}
+
+	mime := http.DetectContentType(data)
+	description := "data"
+	switch {
+	case strings.HasPrefix(mime, "text/plain"):
+		description = "text"
+	case strings.HasPrefix(mime, "text/html"):
+		description = "HTML document"
+	case strings.HasPrefix(mime, "text/xml"):
+		description = "XML document"
+	case strings.HasPrefix(mime, "image/"):
+		description = strings.ToUpper(strings.TrimPrefix(mime, "image/")) + " image"
+	case strings.HasPrefix(mime, "audio/"), strings.HasPrefix(mime, "video/"):
+		description = "media"
+	}
+	return mime, description
+}
+
+// Execute is invoked if the user specifies `filetype` as the subcommand.
+func (f *filetypeCommand) Execute(args []string) int {
+
+	if len(args) < 1 {
+		fmt.Printf("Usage: filetype [-mime-only] file [file ...]\n")
+		return 1
+	}
+
+	ret := 0
+
+	for _, path := range args {
+
+		info, err := os.Stat(path)
+		if err != nil {
+			fmt.Printf("%s: error: %s\n", path, err.Error())
+			ret = 1
+			continue
+		}
+
+		var mime, description string
+		if info.IsDir() {
+			mime, description = "inode/directory", "directory"
+		} else {
+			file, err := os.Open(path)
+			if err != nil {
+				fmt.Printf("%s: error: %s\n", path, err.Error())
+				ret = 1
+				continue
+			}
+
+			buf := make([]byte, 512)
+			n, err := io.ReadFull(file, buf)
+			file.Close()
+			if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+				fmt.Printf("%s: error: %s\n", path, err.Error())
+				ret = 1
+				continue
+			}
+
+			mime, description = f.detect(buf[:n])
+		}
+
+		if f.mimeOnly {
+			fmt.Printf("%s: %s\n", path, mime)
+		} else {
+			fmt.Printf("%s: %s (%s)\n", path, mime, description)
+		}
+	}
+
+	return ret
+}
@@ -0,0 +1,257 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Structure for our options and state.
+type findCommand struct {
+
+	// The glob which names must match.
+	name string
+
+	// The type of entries to find: "f", "d", or "l".
+	kind string
+
+	// The size comparison, e.g. "+10M".
+	size string
+
+	// The age comparison, in days, e.g. "-7".
+	mtime string
+
+	// The command to run for each match.
+	exec string
+}
+
+// findComparison holds a parsed "+N", "-N", or "N" comparison.
+type findComparison struct {
+
+	// -1 for "less than", +1 for "greater than", 0 for "equal to".
+	sign int
+
+	// The value to compare against.
+	value int64
+
+	// The size of each unit.
+	unit int64
+}
+
+// Arguments adds per-command args to the object.
+func (f *findCommand) Arguments(fs *flag.FlagSet) {
+	fs.StringVar(&f.name, "name", "", "Find entries whose name matches the given glob, e.g. '*.go'")
+	fs.StringVar(&f.kind, "type", "", "Find entries of the given type, 'f' for files, 'd' for directories, or 'l' for symlinks")
+	fs.StringVar(&f.size, "size", "", "Find files of the given size, e.g. '+10M' for more than 10MiB, or '-1k' for less than 1KiB")
+	fs.StringVar(&f.mtime, "mtime", "", "Find entries modified the given number of days ago, e.g. '-7' for less than a week")
+	fs.StringVar(&f.exec, "exec", "", "Run the given command for each match, replacing '{}' with its path")
+}
+
+// Info returns the name of this subcommand.
+func (f *findCommand) Info() (string, string) {
+	return "find", `Find files, and directories, matching the given criteria.
+
+Details:
+
+This command is a portable subset of the standard 'find' command.  It
+walks the named directories, or the current directory if none are given,
+and shows each entry which matches all of the given criteria:
+
+   -name GLOB    The name of the entry matches the glob, such as '*.log'.
+   -type TYPE    The entry is a file (f), directory (d), or symlink (l).
+   -size SIZE    The size of the entry, in bytes unless a unit of c (bytes),
+                 k, M, or G is given, for example '+10M' matches entries
+                 larger than 10MiB, '-1k' entries smaller than 1KiB.
+                 Unlike the standard command sizes without a unit are
+                 not counted in 512-byte blocks.
+   -mtime DAYS   The entry was modified DAYS days ago, for example '-7'
+                 matches entries modified within the past week, and '+30'
+                 those modified more than thirty days ago.
+
+With '-exec' the given command is run for each match, rather than its
+path being shown, with '{}' replaced by the path.  The command is not
+passed to a shell.
+
+Entries which cannot be read are reported, and skipped, and result in a
+non-zero exit-code.
+
+Examples:
+
+$ sysbox find -name '*.go' -type f
+$ sysbox find -size +100M /var
+$ sysbox find -mtime +30 -name '*.log' -exec 'gzip {}' /var/log/app`
+}
+
+// findSizeUnits are the units which may be used with -size.
+var findSizeUnits = map[string]int64{
+	"c": 1,
+	"k": 1024,
+	"M": 1024 * 1024,
+	"G": 1024 * 1024 * 1024,
+}
+
+// parseComparison parses a comparison, such as "+10M", using the given
+// units for the suffix.
+func parseComparison(spec string, units map[string]int64, def int64) (*findComparison, error) {
+
+	c := &findComparison{unit: def}
+
+	rest := spec
+	if strings.HasPrefix(rest, "+") {
+		c.sign = 1
+		rest = rest[1:]
+	} else if strings.HasPrefix(rest, "-") {
+		c.sign = -1
+		rest = rest[1:]
+	}
+
+	for suffix, unit := range units {
+		if strings.HasSuffix(rest, suffix) {
+			c.unit = unit
+			rest = strings.TrimSuffix(rest, suffix)
+			break
+		}
+	}
+
+	value, err := strconv.ParseInt(rest, 10, 64)
+	if err != nil || value < 0 {
+		return nil, fmt.Errorf("invalid value '%s'", spec)
+	}
+	c.value = value
+	return c, nil
+}
+
+// matches returns true if the amount matches the comparison, after being
+// rounded up to a whole number of units.
+func (c *findComparison) matches(amount float64) bool {
+
+	units := int64(math.Ceil(amount / float64(c.unit)))
+	switch c.sign {
+	case 1:
+		return units > c.value
+	case -1:
+		return units < c.value
+	}
+	return units == c.value
+}
+
+// run executes the user's command for the given path.
+func (f *findCommand) run(path string) {
+
+	var args []string
+	for _, arg := range strings.Fields(f.exec) {
+		args = append(args, strings.Replace(arg, "{}", path, -1))
+	}
+
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "error running %s: %s\n", args[0], err.Error())
+	}
+}
+
+// Execute is invoked if the user specifies `find` as the subcommand.
+func (f *findCommand) Execute(args []string) int {
+
+	var size, mtime *findComparison
+	var err error
+
+	if f.size != "" {
+		size, err = parseComparison(f.size, findSizeUnits, 1)
+		if err != nil {
+			fmt.Printf("error: -size: %s\n", err.Error())
+			return 1
+		}
+	}
+	if f.mtime != "" {
+		mtime, err = parseComparison(f.mtime, nil, 1)
+		if err != nil {
+			fmt.Printf("error: -mtime: %s\n", err.Error())
+			return 1
+		}
+	}
+	if f.kind != "" && f.kind != "f" && f.kind != "d" && f.kind != "l" {
+		fmt.Printf("error: -type must be 'f', 'd', or 'l'\n")
+		return 1
+	}
+	if f.name != "" {
+		if _, err := filepath.Match(f.name, ""); err != nil {
+			fmt.Printf("error: -name: %s\n", err.Error())
+			return 1
+		}
+	}
+	if f.exec != "" && len(strings.Fields(f.exec)) == 0 {
+		fmt.Printf("error: -exec requires a command\n")
+		return 1
+	}
+
+	if len(args) == 0 {
+		args = []string{"."}
+	}
+
+	now := time.Now()
+	failed := false
+
+	for _, root := range args {
+		filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "warning: %s\n", err.Error())
+				failed = true
+				return nil
+			}
+
+			if f.name != "" {
+				if match, _ := filepath.Match(f.name, info.Name()); !match {
+					return nil
+				}
+			}
+
+			switch f.kind {
+			case "f":
+				if !info.Mode().IsRegular() {
+					return nil
+				}
+			case "d":
+				if !info.IsDir() {
+					return nil
+				}
+			case "l":
+				if info.Mode()&os.ModeSymlink == 0 {
+					return nil
+				}
+			}
+
+			if size != nil && !size.matches(float64(info.Size())) {
+				return nil
+			}
+
+			// Ages are in whole days, rounded down, as with find.
+			if mtime != nil {
+				age := math.Floor(now.Sub(info.ModTime()).Hours() / 24)
+				if !mtime.matches(age) {
+					return nil
+				}
+			}
+
+			if f.exec != "" {
+				f.run(path)
+			} else {
+				fmt.Println(path)
+			}
+			return nil
+		})
+	}
+
+	if failed {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,43 @@
+package main
+
+import "testing"
+
+// TestFindSize tests that -size comparisons match the expected sizes.
+func TestFindSize(t *testing.T) {
+
+	tests := []struct {
+		spec    string
+		size    float64
+		matches bool
+	}{
+		{"+10", 12, true},
+		{"+10", 10, false},
+		{"-10", 9, true},
+		{"-10", 10, false},
+		{"10", 10, true},
+		{"10c", 10, true},
+		{"10c", 11, false},
+		{"+1k", 1025, true},
+		{"+1k", 1024, false},
+		{"-1k", 1000, false},
+		{"-2k", 1000, true},
+		{"1M", 1024 * 1024, true},
+		{"+1G", 1024 * 1024 * 1024, false},
+	}
+
+	for _, test := range tests {
+		c, err := parseComparison(test.spec, findSizeUnits, 1)
+		if err != nil {
+			t.Fatalf("unexpected error parsing '%s': %s", test.spec, err)
+		}
+		if c.matches(test.size) != test.matches {
+			t.Fatalf("'%s' matching %v gave %v, expected %v", test.spec, test.size, !test.matches, test.matches)
+		}
+	}
+
+	for _, spec := range []string{"", "+", "ten", "10x", "--1"} {
+		if _, err := parseComparison(spec, findSizeUnits, 1); err == nil {
+			t.Fatalf("expected an error parsing '%s'", spec)
+		}
+	}
+}
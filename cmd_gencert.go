@@ -0,0 +1,262 @@
+package main
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"flag"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"time"
+)
+
+// Structure for our options and state.
+type gencertCommand struct {
+
+	// The common name of the certificate.
+	cn string
+
+	// The subject alternative names, DNS names or IPs.
+	sans stringList
+
+	// The number of days the certificate is valid for.
+	days int
+
+	// Generate an ECDSA key?
+	ecdsa bool
+
+	// Generate an RSA key?
+	rsa bool
+
+	// Generate an Ed25519 key?
+	ed25519 bool
+
+	// The size of the key, in bits.
+	bits int
+
+	// The file to write the certificate to.
+	certFile string
+
+	// The file to write the private key to.
+	keyFile string
+
+	// Overwrite existing files?
+	force bool
+}
+
+// Arguments adds per-command args to the object.
+func (g *gencertCommand) Arguments(f *flag.FlagSet) {
+	f.StringVar(&g.cn, "cn", "localhost", "The common name of the certificate")
+	f.Var(&g.sans, "san", "A subject alternative name, DNS name or IP address, which may be repeated")
+	f.IntVar(&g.days, "days", 365, "The number of days the certificate is valid for")
+	f.BoolVar(&g.ecdsa, "ecdsa", false, "Generate an ECDSA key (the default)")
+	f.BoolVar(&g.rsa, "rsa", false, "Generate an RSA key")
+	f.BoolVar(&g.ed25519, "ed25519", false, "Generate an Ed25519 key")
+	f.IntVar(&g.bits, "bits", 0, "The size of the key, 2048+ for RSA, or 256, 384, or 521 for ECDSA")
+	f.StringVar(&g.certFile, "cert", "cert.pem", "The file to write the certificate to")
+	f.StringVar(&g.keyFile, "key", "key.pem", "The file to write the private key to")
+	f.BoolVar(&g.force, "force", false, "Overwrite the certificate, and key, if they exist")
+}
+
+// Info returns the name of this subcommand.
+func (g *gencertCommand) Info() (string, string) {
+	return "gencert", `Generate a self-signed TLS certificate.
+
+Details:
+
+This command generates a private key, and a self-signed certificate for
+it, writing them to 'cert.pem' and 'key.pem' in the current directory.
+These are ideal for local development servers.
+
+The certificate's common name is set via '-cn', and is also included as
+a subject alternative name.  Additional names, which may be DNS names or
+IP addresses, may be added by repeating '-san'.
+
+An ECDSA P-256 key is generated by default, but '-rsa' or '-ed25519'
+may be used to choose a different type of key, and '-bits' the size of
+RSA, or ECDSA, keys.
+
+Existing files are not replaced, unless '-force' is given.
+
+Examples:
+
+$ sysbox gencert
+$ sysbox gencert -cn example.test -san www.example.test -san 127.0.0.1
+$ sysbox gencert -rsa -bits 4096 -days 30 -cert server.crt -key server.key`
+}
+
+// generateKey generates the private key the user chose.
+func (g *gencertCommand) generateKey() (crypto.Signer, error) {
+
+	types := 0
+	for _, set := range []bool{g.ecdsa, g.rsa, g.ed25519} {
+		if set {
+			types++
+		}
+	}
+	if types > 1 {
+		return nil, fmt.Errorf("only one of -ecdsa, -rsa, and -ed25519 may be given")
+	}
+
+	switch {
+	case g.rsa:
+		if g.bits == 0 {
+			g.bits = 2048
+		}
+		if g.bits < 2048 {
+			return nil, fmt.Errorf("RSA keys must be at least 2048 bits")
+		}
+		return rsa.GenerateKey(rand.Reader, g.bits)
+
+	case g.ed25519:
+		if g.bits != 0 {
+			return nil, fmt.Errorf("-bits cannot be used with Ed25519 keys")
+		}
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		return key, err
+	}
+
+	curves := map[int]elliptic.Curve{
+		0:   elliptic.P256(),
+		256: elliptic.P256(),
+		384: elliptic.P384(),
+		521: elliptic.P521(),
+	}
+	curve, ok := curves[g.bits]
+	if !ok {
+		return nil, fmt.Errorf("ECDSA keys must be 256, 384, or 521 bits")
+	}
+	return ecdsa.GenerateKey(curve, rand.Reader)
+}
+
+// template returns the certificate to be signed.
+func (g *gencertCommand) template() (*x509.Certificate, error) {
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	cert := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: g.cn},
+		NotBefore:             now.Add(-5 * time.Minute),
+		NotAfter:              now.AddDate(0, 0, g.days),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+	}
+
+	// RSA keys are also used for key-encipherment.
+	if g.rsa {
+		cert.KeyUsage |= x509.KeyUsageKeyEncipherment
+	}
+
+	seen := make(map[string]bool)
+	for _, name := range append([]string{g.cn}, g.sans...) {
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		if ip := net.ParseIP(name); ip != nil {
+			cert.IPAddresses = append(cert.IPAddresses, ip)
+		} else {
+			cert.DNSNames = append(cert.DNSNames, name)
+		}
+	}
+
+	return cert, nil
+}
+
+// writePEM writes a PEM block to the named file.
+func (g *gencertCommand) writePEM(path string, blockType string, data []byte, mode os.FileMode) error {
+
+	flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if !g.force {
+		flags |= os.O_EXCL
+	}
+
+	file, err := os.OpenFile(path, flags, mode)
+	if err != nil {
+		if os.IsExist(err) {
+			return fmt.Errorf("%s already exists, use -force to replace it", path)
+		}
+		return err
+	}
+
+	if err := pem.Encode(file, &pem.Block{Type: blockType, Bytes: data}); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// Execute is invoked if the user specifies `gencert` as the subcommand.
+func (g *gencertCommand) Execute(args []string) int {
+
+	if len(args) != 0 {
+		fmt.Printf("Usage: gencert [-cn NAME] [-san NAME ..] [-days N] [-ecdsa|-rsa|-ed25519]\n")
+		return 1
+	}
+	if g.days < 1 {
+		fmt.Printf("error: -days must be at least 1\n")
+		return 1
+	}
+
+	// Check before we write either file, so we don't leave a
+	// private key without its certificate.
+	if !g.force {
+		for _, path := range []string{g.certFile, g.keyFile} {
+			if _, err := os.Stat(path); err == nil {
+				fmt.Printf("error: %s already exists, use -force to replace it\n", path)
+				return 1
+			}
+		}
+	}
+
+	key, err := g.generateKey()
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+
+	template, err := g.template()
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+
+	keyDer, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+
+	if err := g.writePEM(g.keyFile, "PRIVATE KEY", keyDer, 0600); err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+	if err := g.writePEM(g.certFile, "CERTIFICATE", der, 0644); err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+
+	fmt.Printf("Wrote certificate to %s, and private key to %s\n", g.certFile, g.keyFile)
+	return 0
+}
@@ -0,0 +1,264 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// Structure for our options and state.
+type grepCommand struct {
+
+	// Match case-insensitively?
+	ignoreCase bool
+
+	// Show the lines which don't match, rather than those that do?
+	invert bool
+
+	// Show line-numbers?
+	lineNumbers bool
+
+	// Show only a count of matching lines?
+	count bool
+
+	// Search directories recursively?
+	recursive bool
+
+	// The pattern we're searching for.
+	pattern *regexp.Regexp
+
+	// Show the filename before each match?
+	filenames bool
+
+	// Highlight the matches?
+	color bool
+}
+
+// Arguments adds per-command args to the object.
+func (g *grepCommand) Arguments(f *flag.FlagSet) {
+	f.BoolVar(&g.ignoreCase, "i", false, "Match case-insensitively")
+	f.BoolVar(&g.invert, "v", false, "Show the lines which don't match")
+	f.BoolVar(&g.lineNumbers, "n", false, "Show the line-number of each match")
+	f.BoolVar(&g.count, "c", false, "Show only a count of the matching lines")
+	f.BoolVar(&g.recursive, "r", false, "Search directories recursively")
+}
+
+// Info returns the name of this subcommand.
+func (g *grepCommand) Info() (string, string) {
+	return "grep", `Search files for lines matching a regular expression.
+
+Details:
+
+This command shows the lines of each named file, or of STDIN if no files
+are given, which match the given regular expression.  The expression
+uses Go's syntax, which is documented at
+https://golang.org/pkg/regexp/syntax/
+
+The flags are similar to those of the standard grep:
+
+   -i  Match case-insensitively.
+   -v  Show the lines which don't match.
+   -n  Show the line-number of each match.
+   -c  Show only a count of the matching lines.
+   -r  Search directories recursively, the current directory by default.
+
+When several files are searched each match is preceded by the name of
+its file.  Binary files are reported, rather than shown, when they match.
+If the output is a terminal the matches will be highlighted.
+
+As with grep the exit-code is 0 if a line matched, 1 if none did, and 2
+if there was an error.
+
+Examples:
+
+$ sysbox grep -i error /var/log/syslog
+$ sysbox grep -rn 'func \w+Command' .
+$ ps aux | sysbox grep -c nginx`
+}
+
+// highlight returns the line with each match highlighted.
+func (g *grepCommand) highlight(line string) string {
+
+	var out strings.Builder
+
+	last := 0
+	for _, match := range g.pattern.FindAllStringIndex(line, -1) {
+		if match[0] == match[1] {
+			continue
+		}
+		out.WriteString(line[last:match[0]])
+		out.WriteString("\033[1;31m" + line[match[0]:match[1]] + "\033[0m")
+		last = match[1]
+	}
+	out.WriteString(line[last:])
+
+	return out.String()
+}
+
+// search shows the matching lines read from the given reader, returning
+// true if there were any.
+func (g *grepCommand) search(name string, in io.Reader) (bool, error) {
+
+	reader := bufio.NewReader(in)
+
+	// Look for NUL bytes, to identify binary files.
+	peek, _ := reader.Peek(8192)
+	binary := bytes.IndexByte(peek, 0) >= 0
+
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+
+	prefix := ""
+	if g.filenames {
+		prefix = name + ":"
+	}
+
+	matches := 0
+	number := 0
+	for scanner.Scan() {
+		number++
+
+		line := scanner.Text()
+		if g.pattern.MatchString(line) == g.invert {
+			continue
+		}
+		matches++
+
+		if binary && !g.count {
+			fmt.Printf("Binary file %s matches\n", name)
+			return true, nil
+		}
+		if g.count {
+			continue
+		}
+
+		if g.color && !g.invert {
+			line = g.highlight(line)
+		}
+		if g.lineNumbers {
+			fmt.Printf("%s%d:%s\n", prefix, number, line)
+		} else {
+			fmt.Printf("%s%s\n", prefix, line)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return matches > 0, fmt.Errorf("%s: %s", name, err.Error())
+	}
+
+	if g.count {
+		fmt.Printf("%s%d\n", prefix, matches)
+	}
+	return matches > 0, nil
+}
+
+// searchFile searches the named file, with "-" meaning STDIN.
+func (g *grepCommand) searchFile(path string) (bool, error) {
+
+	if path == "-" {
+		return g.search("(standard input)", os.Stdin)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return false, err
+	}
+	if info.IsDir() {
+		return false, fmt.Errorf("%s: is a directory", path)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	return g.search(path, file)
+}
+
+// Execute is invoked if the user specifies `grep` as the subcommand.
+func (g *grepCommand) Execute(args []string) int {
+
+	if len(args) < 1 {
+		fmt.Printf("Usage: grep [-i] [-v] [-n] [-c] [-r] pattern [file ...]\n")
+		return 2
+	}
+
+	expr := args[0]
+	if g.ignoreCase {
+		expr = "(?i)" + expr
+	}
+
+	var err error
+	g.pattern, err = regexp.Compile(expr)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+		return 2
+	}
+
+	files := args[1:]
+	if len(files) == 0 {
+		if g.recursive {
+			files = []string{"."}
+		} else {
+			files = []string{"-"}
+		}
+	}
+
+	g.filenames = g.recursive || len(files) > 1
+	g.color = terminal.IsTerminal(int(os.Stdout.Fd()))
+
+	matched := false
+	failed := false
+
+	search := func(path string) {
+		found, err := g.searchFile(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+			failed = true
+		}
+		if found {
+			matched = true
+		}
+	}
+
+	for _, path := range files {
+
+		if !g.recursive || path == "-" {
+			search(path)
+			continue
+		}
+
+		err := filepath.Walk(path, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+				failed = true
+				return nil
+			}
+			if info.Mode().IsRegular() {
+				search(path)
+			}
+			return nil
+		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+			failed = true
+		}
+	}
+
+	if failed {
+		return 2
+	}
+	if matched {
+		return 0
+	}
+	return 1
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"compress/gzip"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// Structure for our options and state.
+type gzipCommand struct {
+
+	// Decompress, rather than compress?
+	decompress bool
+
+	// The file to write to, rather than STDOUT.
+	output string
+
+	// The compression level.
+	level int
+}
+
+// Arguments adds per-command args to the object.
+func (g *gzipCommand) Arguments(f *flag.FlagSet) {
+	f.BoolVar(&g.decompress, "d", false, "Decompress, rather than compress")
+	f.StringVar(&g.output, "o", "", "Write to the given file, rather than STDOUT")
+	f.IntVar(&g.level, "level", gzip.DefaultCompression, "The compression level, from 1 (fastest) to 9 (smallest)")
+}
+
+// Info returns the name of this subcommand.
+func (g *gzipCommand) Info() (string, string) {
+	return "gzip", `Compress, or decompress, data with gzip.
+
+Details:
+
+This command compresses the named file, or STDIN if no file is given,
+writing the result to STDOUT, or to the file named via '-o'.  The input
+is streamed, so files of any size may be processed.
+
+When compressing a file its name, and modification time, are stored in
+the gzip header, as the standard gzip tool does.  The '-level' flag
+chooses between faster compression (1) and smaller output (9).
+
+The '-d' flag decompresses the input instead.
+
+Examples:
+
+$ sysbox gzip access.log > access.log.gz
+$ sysbox gzip -level 9 -o backup.sql.gz backup.sql
+$ sysbox gzip -d access.log.gz | sysbox grep 404`
+}
+
+// compressStream compresses everything from the reader to the writer.
+func (g *gzipCommand) compressStream(in io.Reader, out io.Writer, info os.FileInfo) error {
+
+	writer, err := gzip.NewWriterLevel(out, g.level)
+	if err != nil {
+		return err
+	}
+
+	// Record the original name, and modification time, of files.
+	if info != nil {
+		writer.Name = filepath.Base(info.Name())
+		writer.ModTime = info.ModTime()
+	}
+
+	if _, err := io.Copy(writer, in); err != nil {
+		return err
+	}
+	return writer.Close()
+}
+
+// decompressStream decompresses everything from the reader to the writer.
+func (g *gzipCommand) decompressStream(in io.Reader, out io.Writer) error {
+
+	reader, err := gzip.NewReader(in)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	_, err = io.Copy(out, reader)
+	return err
+}
+
+// Execute is invoked if the user specifies `gzip` as the subcommand.
+func (g *gzipCommand) Execute(args []string) int {
+
+	if len(args) > 1 {
+		fmt.Printf("Usage: gzip [-d] [-o output] [file]\n")
+		return 1
+	}
+	if g.level != gzip.DefaultCompression && (g.level < gzip.BestSpeed || g.level > gzip.BestCompression) {
+		fmt.Printf("error: -level must be between 1 and 9\n")
+		return 1
+	}
+
+	var in io.Reader = os.Stdin
+	var info os.FileInfo
+	if len(args) == 1 && args[0] != "-" {
+		file, err := os.Open(args[0])
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			return 1
+		}
+		defer file.Close()
+
+		info, err = file.Stat()
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			return 1
+		}
+		in = file
+	}
+
+	var out io.Writer = os.Stdout
+	if g.output != "" {
+		file, err := os.Create(g.output)
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			return 1
+		}
+		defer file.Close()
+		out = file
+	} else if !g.decompress && terminal.IsTerminal(int(os.Stdout.Fd())) {
+		fmt.Printf("error: refusing to write compressed data to a terminal\n")
+		return 1
+	}
+
+	var err error
+	if g.decompress {
+		err = g.decompressStream(in, out)
+	} else {
+		err = g.compressStream(in, out, info)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+		return 1
+	}
+	return 0
+}
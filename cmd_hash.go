@@ -0,0 +1,184 @@
+package main
+
+import (
+	"bufio"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"os"
+	"strings"
+)
+
+// Structure for our options and state.
+type hashCommand struct {
+
+	// The algorithm to use.
+	algorithm string
+
+	// Verify the checksums in the given files, rather than creating them?
+	check bool
+}
+
+// hashAlgorithms holds the algorithms we support, and a constructor for each.
+var hashAlgorithms = map[string]func() hash.Hash{
+	"crc32":  func() hash.Hash { return crc32.NewIEEE() },
+	"md5":    md5.New,
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+	"sha512": sha512.New,
+}
+
+// Arguments adds per-command args to the object.
+func (h *hashCommand) Arguments(f *flag.FlagSet) {
+	f.StringVar(&h.algorithm, "a", "sha256", "The algorithm to use (crc32, md5, sha1, sha256, or sha512)")
+	f.BoolVar(&h.check, "c", false, "Verify the checksums listed in the given files")
+}
+
+// Info returns the name of this subcommand.
+func (h *hashCommand) Info() (string, string) {
+	return "hash", `Calculate, or verify, the checksums of files.
+
+Details:
+
+This command calculates the checksum of each named file, or of STDIN if
+no files are given, and outputs them in the same format as the coreutils
+tools, such as 'sha256sum'.
+
+SHA256 is used by default, but '-a' allows a different algorithm to be
+chosen, from crc32, md5, sha1, sha256, or sha512.
+
+When '-c' is given the arguments are taken to be files of checksums, in
+that same format, and each file they list is verified.  If any file
+fails verification a non-zero exit-code is returned.
+
+Examples:
+
+$ sysbox hash -a md5 /etc/passwd
+$ sysbox hash *.tar.gz > SHA256SUMS
+$ sysbox hash -c SHA256SUMS`
+}
+
+// sum returns the checksum of everything read from the given reader.
+func (h *hashCommand) sum(in io.Reader) (string, error) {
+
+	hasher := hashAlgorithms[h.algorithm]()
+	if _, err := io.Copy(hasher, in); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// sumFile returns the checksum of the named file, with "-" meaning STDIN.
+func (h *hashCommand) sumFile(path string) (string, error) {
+
+	if path == "-" {
+		return h.sum(os.Stdin)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	return h.sum(file)
+}
+
+// verify checks each of the checksums listed in the given file, and
+// returns true if they're all OK.
+func (h *hashCommand) verify(path string) bool {
+
+	var in io.Reader = os.Stdin
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			return false
+		}
+		defer file.Close()
+		in = file
+	}
+
+	ok := true
+
+	scanner := bufio.NewScanner(in)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		// "checksum  name", or "checksum *name" for binary-mode.
+		parts := strings.SplitN(line, " ", 2)
+		if len(parts) != 2 || len(parts[1]) < 2 {
+			fmt.Printf("%s: improperly formatted line '%s'\n", path, line)
+			ok = false
+			continue
+		}
+		expected := strings.ToLower(parts[0])
+		name := parts[1][1:]
+
+		actual, err := h.sumFile(name)
+		switch {
+		case err != nil:
+			fmt.Printf("%s: FAILED open or read\n", name)
+			ok = false
+		case actual != expected:
+			fmt.Printf("%s: FAILED\n", name)
+			ok = false
+		default:
+			fmt.Printf("%s: OK\n", name)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return false
+	}
+
+	return ok
+}
+
+// Execute is invoked if the user specifies `hash` as the subcommand.
+func (h *hashCommand) Execute(args []string) int {
+
+	h.algorithm = strings.ToLower(h.algorithm)
+	if _, ok := hashAlgorithms[h.algorithm]; !ok {
+		fmt.Printf("error: unknown algorithm '%s'\n", h.algorithm)
+		return 1
+	}
+
+	// Default to reading STDIN.
+	if len(args) == 0 {
+		args = []string{"-"}
+	}
+
+	ret := 0
+
+	for _, path := range args {
+
+		if h.check {
+			if !h.verify(path) {
+				ret = 1
+			}
+			continue
+		}
+
+		sum, err := h.sumFile(path)
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			ret = 1
+			continue
+		}
+		fmt.Printf("%s  %s\n", sum, path)
+	}
+
+	return ret
+}
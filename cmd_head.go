@@ -0,0 +1,191 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+)
+
+// Structure for our options and state.
+type headCommand struct {
+
+	// The number of lines to show.
+	lines int
+
+	// The number of bytes to show, if set.
+	bytes string
+
+	// The name of the last file we showed a header for.
+	last string
+}
+
+// Arguments adds per-command args to the object.
+func (h *headCommand) Arguments(f *flag.FlagSet) {
+	f.IntVar(&h.lines, "n", 10, "The number of lines to show, or with a leading '-' all but the last N lines")
+	f.StringVar(&h.bytes, "c", "", "The number of bytes to show, or with a leading '-' all but the last N bytes")
+}
+
+// Info returns the name of this subcommand.
+func (h *headCommand) Info() (string, string) {
+	return "head", `Show the start of files.
+
+Details:
+
+This command shows the first ten lines of the named files, or of STDIN
+if none are given.  The number of lines may be changed via '-n', or the
+'-c' flag may be used to show the given number of bytes instead.
+
+A negative count shows everything except the last lines, or bytes, so
+'-n -5' shows all but the last five lines.
+
+When several files are given the output of each is preceded by a header
+such as '==> /etc/passwd <=='.
+
+Examples:
+
+$ sysbox head /etc/passwd
+$ sysbox head -n 1 *.csv
+$ sysbox head -c 512 disk.img | sysbox hexdump
+$ sysbox head -n -1 report.txt`
+}
+
+// header shows the name of a file, when we're showing several.
+func (h *headCommand) header(out *bufio.Writer, name string) {
+
+	if h.last != "" {
+		out.WriteString("\n")
+	}
+	fmt.Fprintf(out, "==> %s <==\n", name)
+	h.last = name
+}
+
+// headLines copies the selected lines of the input to the output.
+func (h *headCommand) headLines(in io.Reader, out *bufio.Writer, count int) error {
+
+	reader := bufio.NewReader(in)
+
+	// Lines we're holding back, in case they're the last ones.
+	var pending []string
+
+	for shown := 0; count < 0 || shown < count; {
+		line, err := reader.ReadString('\n')
+		if len(line) > 0 {
+			if count >= 0 {
+				out.WriteString(line)
+				shown++
+			} else {
+				pending = append(pending, line)
+				if len(pending) > -count {
+					out.WriteString(pending[0])
+					pending = pending[1:]
+				}
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// headBytes copies the selected bytes of the input to the output.
+func (h *headCommand) headBytes(in io.Reader, out *bufio.Writer, count int64) error {
+
+	if count >= 0 {
+		_, err := io.CopyN(out, in, count)
+		if err == io.EOF {
+			return nil
+		}
+		return err
+	}
+
+	// Bytes we're holding back, in case they're the last ones.
+	var pending []byte
+
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := in.Read(buf)
+		pending = append(pending, buf[:n]...)
+		if keep := int(-count); len(pending) > keep {
+			out.Write(pending[:len(pending)-keep])
+			pending = append([]byte{}, pending[len(pending)-keep:]...)
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Execute is invoked if the user specifies `head` as the subcommand.
+func (h *headCommand) Execute(args []string) int {
+
+	var bytes int64
+	if h.bytes != "" {
+		var err error
+		bytes, err = strconv.ParseInt(h.bytes, 10, 64)
+		if err != nil {
+			fmt.Printf("error: invalid byte count '%s'\n", h.bytes)
+			return 1
+		}
+	}
+
+	// Default to reading STDIN.
+	if len(args) == 0 {
+		args = []string{"-"}
+	}
+
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+
+	ret := 0
+
+	for _, path := range args {
+
+		in := os.Stdin
+		if path != "-" {
+			file, err := os.Open(path)
+			if err != nil {
+				out.Flush()
+				fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+				ret = 1
+				continue
+			}
+			in = file
+		}
+
+		if len(args) > 1 {
+			name := path
+			if path == "-" {
+				name = "standard input"
+			}
+			h.header(out, name)
+		}
+
+		var err error
+		if h.bytes != "" {
+			err = h.headBytes(in, out, bytes)
+		} else {
+			err = h.headLines(in, out, h.lines)
+		}
+		if in != os.Stdin {
+			in.Close()
+		}
+
+		if err != nil {
+			out.Flush()
+			fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+			ret = 1
+		}
+	}
+
+	return ret
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"bufio"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Structure for our options and state.
+type hexdumpCommand struct {
+
+	// The number of bytes to show upon each line.
+	columns int
+
+	// The number of bytes in each group.
+	group int
+
+	// Convert a dump back into binary?
+	reverse bool
+}
+
+// Arguments adds per-command args to the object.
+func (h *hexdumpCommand) Arguments(f *flag.FlagSet) {
+	f.IntVar(&h.columns, "c", 16, "The number of bytes to show upon each line")
+	f.IntVar(&h.group, "g", 2, "The number of bytes in each group, or 0 for no grouping")
+	f.BoolVar(&h.reverse, "r", false, "Convert a hex dump back into binary")
+}
+
+// Info returns the name of this subcommand.
+func (h *hexdumpCommand) Info() (string, string) {
+	return "hexdump", `Show a hex dump of a file, or reverse one.
+
+Details:
+
+This command shows the contents of the named file, or STDIN if no file is
+given, as a hex dump in the same format as 'xxd'.  Each line shows the
+offset, the bytes in hexadecimal, and the printable ASCII characters:
+
+   00000000: 4865 6c6c 6f2c 2057 6f72 6c64 0a         Hello, World.
+
+The number of bytes upon each line may be changed via '-c', and the size
+of each group of bytes via '-g'.
+
+With '-r' the process is reversed, and a hex dump is converted back into
+binary, which is written to STDOUT.  Plain hex, without offsets, may be
+converted too.
+
+Examples:
+
+$ sysbox hexdump /bin/ls | head
+$ sysbox hexdump -c 8 -g 1 data.bin
+$ sysbox hexdump data.bin > data.hex; vi data.hex; sysbox hexdump -r data.hex > data.bin`
+}
+
+// dump writes the hex dump of everything from the reader.
+func (h *hexdumpCommand) dump(in io.Reader, out *bufio.Writer) error {
+
+	// The width of the hex column, for padding the final line.
+	width := h.columns * 2
+	if h.group > 0 {
+		width += (h.columns+h.group-1)/h.group - 1
+	}
+
+	buf := make([]byte, h.columns)
+	offset := 0
+
+	for {
+		n, err := io.ReadFull(in, buf)
+		if n > 0 {
+			line := buf[:n]
+
+			var hexed strings.Builder
+			for i := 0; i < n; i++ {
+				if h.group > 0 && i > 0 && i%h.group == 0 {
+					hexed.WriteString(" ")
+				}
+				hexed.WriteString(hex.EncodeToString(line[i : i+1]))
+			}
+
+			ascii := make([]byte, n)
+			for i, c := range line {
+				if c >= 0x20 && c < 0x7f {
+					ascii[i] = c
+				} else {
+					ascii[i] = '.'
+				}
+			}
+
+			fmt.Fprintf(out, "%08x: %-*s  %s\n", offset, width, hexed.String(), ascii)
+			offset += n
+		}
+
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// undump converts a hex dump, from the reader, back into binary.
+func (h *hexdumpCommand) undump(in io.Reader, out *bufio.Writer) error {
+
+	scanner := bufio.NewScanner(in)
+	number := 0
+
+	for scanner.Scan() {
+		number++
+		line := scanner.Text()
+
+		// Remove the offset, and the ASCII column, if present.
+		if i := strings.Index(line, ": "); i >= 0 {
+			line = line[i+2:]
+			if j := strings.Index(line, "  "); j >= 0 {
+				line = line[:j]
+			}
+		}
+
+		data, err := hex.DecodeString(strings.Join(strings.Fields(line), ""))
+		if err != nil {
+			return fmt.Errorf("line %d: %s", number, err.Error())
+		}
+		out.Write(data)
+	}
+
+	return scanner.Err()
+}
+
+// Execute is invoked if the user specifies `hexdump` as the subcommand.
+func (h *hexdumpCommand) Execute(args []string) int {
+
+	if len(args) > 1 {
+		fmt.Printf("Usage: hexdump [-c columns] [-g group] [-r] [file]\n")
+		return 1
+	}
+	if h.columns < 1 {
+		fmt.Printf("error: -c must be at least 1\n")
+		return 1
+	}
+	if h.group < 0 {
+		fmt.Printf("error: -g must not be negative\n")
+		return 1
+	}
+
+	var in io.Reader = os.Stdin
+	if len(args) == 1 && args[0] != "-" {
+		file, err := os.Open(args[0])
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			return 1
+		}
+		defer file.Close()
+		in = file
+	}
+
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+
+	var err error
+	if h.reverse {
+		err = h.undump(in, out)
+	} else {
+		err = h.dump(bufio.NewReader(in), out)
+	}
+
+	if err != nil {
+		out.Flush()
+		fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+		return 1
+	}
+	return 0
+}
@@ -1,62 +1,1382 @@
 package main
 
 import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net"
 	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptrace"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
 
-	"github.com/skx/subcommands"
+	"github.com/dustin/go-humanize"
+	"golang.org/x/crypto/ssh/terminal"
 )
 
+// cookieJar is a cookie-jar which remembers each cookie it is given, so
+// that they may be saved to a file afterwards.
+type cookieJar struct {
+	*cookiejar.Jar
+
+	// The cookies we've been given, and a mutex to protect them.
+	cookies []*http.Cookie
+	mutex   sync.Mutex
+}
+
+// newCookieJar creates a new, empty, cookie-jar.
+func newCookieJar() *cookieJar {
+
+	// This only fails if given options with a bogus public-suffix list.
+	jar, _ := cookiejar.New(nil)
+	return &cookieJar{Jar: jar}
+}
+
+// SetCookies stores the cookies received from the given URL.
+func (j *cookieJar) SetCookies(u *url.URL, cookies []*http.Cookie) {
+	j.Jar.SetCookies(u, cookies)
+
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	for _, c := range cookies {
+		cookie := *c
+
+		// Host-only cookies are recorded against the host, and domain
+		// cookies with a leading "." as in the Netscape format.
+		if cookie.Domain == "" {
+			cookie.Domain = u.Hostname()
+		} else {
+			cookie.Domain = "." + strings.TrimPrefix(cookie.Domain, ".")
+		}
+		if cookie.Path == "" {
+			cookie.Path = "/"
+		}
+		if cookie.MaxAge > 0 {
+			cookie.Expires = time.Now().Add(time.Duration(cookie.MaxAge) * time.Second)
+		}
+
+		j.cookies = append(j.cookies, &cookie)
+	}
+}
+
+// load reads cookies from the given Netscape-format cookie file.
+func (j *cookieJar) load(filename string) error {
+
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	line := 0
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+
+		httpOnly := false
+		if strings.HasPrefix(text, "#HttpOnly_") {
+			text = strings.TrimPrefix(text, "#HttpOnly_")
+			httpOnly = true
+		}
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		// domain, subdomains, path, secure, expiry, name, value
+		fields := strings.Split(text, "\t")
+		if len(fields) != 7 {
+			return fmt.Errorf("%s:%d: malformed cookie", filename, line)
+		}
+
+		cookie := &http.Cookie{
+			Name:     fields[5],
+			Value:    fields[6],
+			Path:     fields[2],
+			Secure:   fields[3] == "TRUE",
+			HttpOnly: httpOnly,
+		}
+
+		expires, err := strconv.ParseInt(fields[4], 10, 64)
+		if err != nil {
+			return fmt.Errorf("%s:%d: malformed expiry '%s'", filename, line, fields[4])
+		}
+		if expires > 0 {
+			cookie.Expires = time.Unix(expires, 0)
+		}
+
+		domain := strings.TrimPrefix(fields[0], ".")
+		if fields[1] == "TRUE" {
+			cookie.Domain = domain
+		}
+
+		scheme := "http"
+		if cookie.Secure {
+			scheme = "https"
+		}
+		j.SetCookies(&url.URL{Scheme: scheme, Host: domain, Path: cookie.Path}, []*http.Cookie{cookie})
+	}
+
+	return scanner.Err()
+}
+
+// save writes the cookies we've seen to the given file, in the Netscape
+// format, skipping any which have expired.
+func (j *cookieJar) save(filename string) error {
+
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	// Later cookies replace earlier ones with the same name.
+	var cookies []*http.Cookie
+	seen := make(map[string]int)
+	for _, c := range j.cookies {
+		key := c.Domain + "\t" + c.Path + "\t" + c.Name
+		if i, ok := seen[key]; ok {
+			cookies[i] = c
+			continue
+		}
+		seen[key] = len(cookies)
+		cookies = append(cookies, c)
+	}
+
+	var out bytes.Buffer
+	out.WriteString("# Netscape HTTP Cookie File\n\n")
+
+	now := time.Now()
+	for _, c := range cookies {
+		if c.MaxAge < 0 || (!c.Expires.IsZero() && c.Expires.Before(now)) {
+			continue
+		}
+
+		prefix := ""
+		if c.HttpOnly {
+			prefix = "#HttpOnly_"
+		}
+		expires := int64(0)
+		if !c.Expires.IsZero() {
+			expires = c.Expires.Unix()
+		}
+
+		fmt.Fprintf(&out, "%s%s\t%s\t%s\t%s\t%d\t%s\t%s\n",
+			prefix, c.Domain, strings.ToUpper(strconv.FormatBool(strings.HasPrefix(c.Domain, "."))),
+			c.Path, strings.ToUpper(strconv.FormatBool(c.Secure)), expires, c.Name, c.Value)
+	}
+
+	return ioutil.WriteFile(filename, out.Bytes(), 0600)
+}
+
+// writeHeaders writes the given headers to the writer, sorted by name,
+// with each line having the given prefix.
+func writeHeaders(w io.Writer, prefix string, header http.Header) {
+
+	var names []string
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, value := range header[name] {
+			fmt.Fprintf(w, "%s%s: %s\n", prefix, name, value)
+		}
+	}
+}
+
+// verboseTransport is a http.RoundTripper which shows each request and
+// response upon STDERR, along with the timings of the connection.
+type verboseTransport struct {
+	transport http.RoundTripper
+}
+
+// RoundTrip makes the given request, showing the details as it goes.
+func (v *verboseTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+
+	start := time.Now()
+	var dnsStart, connectStart, tlsStart time.Time
+
+	trace := &httptrace.ClientTrace{
+		DNSStart: func(info httptrace.DNSStartInfo) {
+			dnsStart = time.Now()
+		},
+		DNSDone: func(info httptrace.DNSDoneInfo) {
+			fmt.Fprintf(os.Stderr, "* DNS lookup took %s\n", time.Since(dnsStart))
+		},
+		ConnectStart: func(network, addr string) {
+			connectStart = time.Now()
+			fmt.Fprintf(os.Stderr, "* Connecting to %s\n", addr)
+		},
+		ConnectDone: func(network, addr string, err error) {
+			if err == nil {
+				fmt.Fprintf(os.Stderr, "* Connected to %s in %s\n", addr, time.Since(connectStart))
+			}
+		},
+		TLSHandshakeStart: func() {
+			tlsStart = time.Now()
+		},
+		TLSHandshakeDone: func(state tls.ConnectionState, err error) {
+			if err == nil {
+				fmt.Fprintf(os.Stderr, "* TLS handshake took %s\n", time.Since(tlsStart))
+			}
+		},
+		GotConn: func(info httptrace.GotConnInfo) {
+			if info.Reused {
+				fmt.Fprintf(os.Stderr, "* Reusing connection to %s\n", info.Conn.RemoteAddr())
+			}
+		},
+		GotFirstResponseByte: func() {
+			fmt.Fprintf(os.Stderr, "* First response byte after %s\n", time.Since(start))
+		},
+	}
+	req = req.WithContext(httptrace.WithClientTrace(req.Context(), trace))
+
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	proto := req.Proto
+	if proto == "" {
+		proto = "HTTP/1.1"
+	}
+	fmt.Fprintf(os.Stderr, "> %s %s %s\n", req.Method, req.URL.RequestURI(), proto)
+	fmt.Fprintf(os.Stderr, "> Host: %s\n", host)
+	writeHeaders(os.Stderr, "> ", req.Header)
+	fmt.Fprintf(os.Stderr, ">\n")
+
+	response, err := v.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Fprintf(os.Stderr, "< %s %s\n", response.Proto, response.Status)
+	writeHeaders(os.Stderr, "< ", response.Header)
+	fmt.Fprintf(os.Stderr, "<\n")
+
+	return response, nil
+}
+
+// progressWriter counts the bytes written through it, and periodically
+// shows the progress of the transfer upon STDERR.
+type progressWriter struct {
+
+	// The expected size of the transfer, or -1 if unknown.
+	total int64
+
+	// The number of bytes written so far.
+	written int64
+
+	// When the transfer started, and when we last showed progress.
+	start time.Time
+	shown time.Time
+
+	// The number of times we've shown progress, used for the spinner.
+	count int
+}
+
+// Write counts the given bytes, and updates the display if necessary.
+func (p *progressWriter) Write(b []byte) (int, error) {
+	p.written += int64(len(b))
+
+	if time.Since(p.shown) >= 100*time.Millisecond {
+		p.show()
+	}
+	return len(b), nil
+}
+
+// show displays the current progress.
+func (p *progressWriter) show() {
+
+	p.shown = time.Now()
+	p.count++
+
+	rate := int64(0)
+	if elapsed := time.Since(p.start).Seconds(); elapsed > 0 {
+		rate = int64(float64(p.written) / elapsed)
+	}
+
+	if p.total > 0 {
+		fmt.Fprintf(os.Stderr, "\r%3d%% %s / %s  %s/s   ",
+			p.written*100/p.total, humanize.Bytes(uint64(p.written)), humanize.Bytes(uint64(p.total)), humanize.Bytes(uint64(rate)))
+	} else {
+		fmt.Fprintf(os.Stderr, "\r%c %s  %s/s   ",
+			`|/-\`[p.count%4], humanize.Bytes(uint64(p.written)), humanize.Bytes(uint64(rate)))
+	}
+}
+
+// finish shows the final state of the transfer.
+func (p *progressWriter) finish() {
+	p.show()
+	fmt.Fprintf(os.Stderr, "\n")
+}
+
 // Structure for our options and state.
 type httpGetCommand struct {
 
-	// We embed the NoFlags option, because we accept no command-line flags.
-	subcommands.NoFlags
+	// Headers to add to the request, as "Name: Value".
+	headers stringList
+
+	// The HTTP method to use.
+	method string
+
+	// The body of the request, "@file" to read from a file, or "-" to
+	// read from STDIN.
+	data string
+
+	// The maximum time the request may take, zero for no limit.
+	timeout time.Duration
+
+	// A file to write the response body to.
+	output string
+
+	// Write the response body to a file named after the URL?
+	remoteName bool
+
+	// The maximum number of redirects to follow.
+	maxRedirects int
+
+	// Don't follow redirects at all?
+	noRedirect bool
+
+	// Credentials for basic-authentication, as "user:pass" or "user".
+	user string
+
+	// The number of times to retry a failed request.
+	retry int
+
+	// The delay before the first retry, which doubles for each attempt.
+	retryDelay time.Duration
+
+	// Show the response headers before the body?
+	include bool
+
+	// Make a HEAD request, and show only the response headers?
+	head bool
+
+	// Skip verification of TLS certificates?
+	insecure bool
+
+	// Pretty-print the response as JSON, regardless of its type?
+	json bool
+
+	// Don't decompress the response body?
+	raw bool
+
+	// Cookies to send, as "name=value" pairs, or a cookie file to read.
+	cookies string
+
+	// A file to save cookies to.
+	saveCookies string
+
+	// The cookie-jar used for our requests.
+	jar *cookieJar
+
+	// Fail, without showing the body, on HTTP errors?
+	fail bool
+
+	// Show only the status code of the response?
+	status bool
+
+	// Show the details of the request and response upon STDERR?
+	verbose bool
+
+	// Form fields to send, as "name=value", or "name=@file".
+	form stringList
+
+	// A Unix domain socket to connect to, instead of the URL's host.
+	unixSocket string
+
+	// The number of URLs to fetch concurrently.
+	jobs int
+
+	// Suppress the display of download progress?
+	quiet bool
+
+	// Resume a partial download, when saving to a file?
+	resume bool
+}
+
+// Arguments adds per-command args to the object.
+func (hg *httpGetCommand) Arguments(f *flag.FlagSet) {
+	f.Var(&hg.headers, "H", "Add a header to the request, as 'Name: Value'.  May be repeated.")
+	f.IntVar(&hg.jobs, "j", 4, "The number of URLs to fetch concurrently, when given several")
+	f.StringVar(&hg.method, "X", "", "The HTTP method to use (GET, POST, PUT, DELETE, PATCH, HEAD, or OPTIONS)")
+	f.Var(&hg.form, "F", "Add a form field to the request, as 'name=value', or 'name=@file' to upload a file.  May be repeated.")
+	f.StringVar(&hg.data, "d", "", "The body of the request, '@file' to read it from a file, or '-' to read from STDIN")
+	f.DurationVar(&hg.timeout, "timeout", 30*time.Second, "The maximum time the request may take, 0 for no limit")
+	f.StringVar(&hg.output, "o", "", "Write the response body to the given file")
+	f.BoolVar(&hg.resume, "continue", false, "Resume a partial download, when saving to a file")
+	f.BoolVar(&hg.remoteName, "O", false, "Write the response body to a file named after the URL")
+	f.IntVar(&hg.maxRedirects, "max-redirects", 10, "The maximum number of redirects to follow")
+	f.BoolVar(&hg.noRedirect, "no-redirect", false, "Don't follow redirects, show them instead")
+	f.BoolVar(&hg.raw, "raw", false, "Don't decompress gzip, or deflate, encoded responses")
+	f.BoolVar(&hg.json, "json", false, "Pretty-print the response as JSON, regardless of its Content-Type")
+	f.BoolVar(&hg.insecure, "insecure", false, "Don't verify TLS certificates.  This is insecure!")
+	f.BoolVar(&hg.insecure, "k", false, "Short form of -insecure")
+	f.BoolVar(&hg.fail, "fail", false, "Exit with an error, without showing the body, if the response status is 400 or higher")
+	f.BoolVar(&hg.status, "status", false, "Show only the numeric status code of the response")
+	f.BoolVar(&hg.verbose, "v", false, "Show the request, response, and connection timings upon STDERR")
+	f.BoolVar(&hg.include, "i", false, "Show the response status and headers before the body")
+	f.BoolVar(&hg.head, "I", false, "Make a HEAD request, and show only the response status and headers")
+	f.IntVar(&hg.retry, "retry", 0, "The number of times to retry a failed request")
+	f.DurationVar(&hg.retryDelay, "retry-delay", time.Second, "The delay before the first retry, which doubles with each attempt")
+	f.StringVar(&hg.cookies, "b", "", "Cookies to send, as 'name=value; name2=value2', or the name of a cookie file to read")
+	f.StringVar(&hg.saveCookies, "c", "", "Save received cookies to the given file")
+	f.StringVar(&hg.unixSocket, "unix-socket", "", "Connect to the given Unix domain socket, rather than the host in the URL")
+	f.StringVar(&hg.user, "u", "", "Credentials for basic-authentication, as 'user:pass', or 'user' to be prompted for the password")
 }
 
 // Info returns the name of this subcommand.
 func (hg *httpGetCommand) Info() (string, string) {
-	return "http-get", `Fetch a remote URL
+	return "http-get", `Fetch a remote URL, or make other HTTP requests.
 
 Details:
 
 This command is very much curl-lite, allowing you to fetch the contents of
-a remote URL, with no configuration options of any kind.
+a remote URL, with minimal configuration options.
 
 While it is unusual to find hosts without curl or wget installed it does
 happen, this command will bridge the gap a little.
 
+By default a GET request is made, but other methods may be chosen via
+the '-X' flag.
+
+A request-body may be sent via '-d', which may contain literal text, or
+'@path' to read the body from a file, or '-' to read it from STDIN.  When
+a body is present the default method is POST, and the Content-Type
+defaults to 'application/x-www-form-urlencoded'.
+
+The response status and headers may be shown before the body via '-i',
+or '-I' may be used to make a HEAD request and show only the headers.
+
+For scripting '-fail' will result in a non-zero exit-code, without the
+body being shown, if the response has a status of 400 or higher.  The
+'-status' flag will show only the numeric status code of the response.
+
+For debugging '-v' will show the request and response headers, along
+with the DNS, connection, and TLS timings, upon STDERR.
+
+Forms may be submitted via '-F name=value', which may be repeated.  If
+any field has a value of the form '@path' the named file is uploaded,
+and the form is sent as 'multipart/form-data' rather than being
+URL-encoded.
+
+Services listening upon a Unix domain socket may be queried by using
+'-unix-socket path'.  The path of the URL is used for the request, but
+the host is just a placeholder.
+
+Requests will time out after 30 seconds, this may be changed via the
+'-timeout' flag, which accepts values such as '10s', or '2m'.  A timeout
+of '0' disables the limit.
+
+Responses compressed with gzip, or deflate, are decompressed unless the
+'-raw' flag is given.
+
+Responses with a Content-Type of 'application/json' are pretty-printed
+when written to STDOUT, and '-json' will do the same for any response.
+
+The response body is written to STDOUT by default, but may be saved to a
+file via '-o path', or to a file named after the final component of the
+URL via '-O'.  When saving to a file an HTTP error status will result in
+a non-zero exit-code, and the progress of the download is shown if STDERR
+is a terminal.
+
+Interrupted downloads may be resumed via '-continue', which will request
+only the remainder of the file.  If the server doesn't support that the
+whole file will be downloaded again.
+
+Up to 10 redirects will be followed, this may be changed via the
+'-max-redirects' flag.  To see a redirect rather than follow it use
+'-no-redirect', which will show the status and the Location header of
+the response.
+
+TLS certificates are verified by default, but '-k' or '-insecure' will
+disable that, allowing self-signed certificates to be accepted.  This
+leaves you open to man-in-the-middle attacks, so use it with care.
+
+Failed requests may be retried via '-retry N', which will retry upon
+timeouts, refused or dropped connections, server errors (5xx), or
+rate-limiting (429).  Errors which would recur, such as invalid
+certificates or too many redirects, are not retried.  The delay between attempts starts at the value given via '-retry-delay' and
+doubles each time, unless the server specifies a Retry-After header.
+
+Cookies may be sent via '-b', either as a string such as 'name=value;
+name2=value2', or by naming a Netscape-format cookie file to read them
+from.  Cookies received, including those set during redirects, may be
+saved to such a file via '-c', allowing sessions to persist across
+invocations.
+
+Basic-authentication may be used by specifying '-u user:pass'.  If
+only a username is given you'll be prompted for the password, which will
+not be echoed.
+
+Multiple URLs may be given, in which case they're fetched concurrently,
+four at a time by default, which may be changed via '-j'.  Each response
+is shown with the URL as a heading, unless '-O' is used to save them to
+files, which is refused if two URLs would be saved to the same name.  If
+any URL fails a non-zero exit-code is returned.
+
 Examples:
 
-$ sysbox http-get https://steve.fi/`
+$ sysbox http-get https://steve.fi/
+$ sysbox http-get -H 'Accept: application/json' https://example.com/
+$ sysbox http-get -X DELETE https://api.example.com/item/1
+$ sysbox http-get -I https://example.com/
+$ sysbox http-get -status https://example.com/
+$ sysbox http-get -O https://example.com/release.tar.gz
+$ sysbox http-get -continue -O https://example.com/release.tar.gz
+$ sysbox http-get -j 8 -O https://example.com/a.iso https://example.com/b.iso
+$ sysbox http-get -no-redirect https://bit.ly/example
+$ sysbox http-get -u steve https://example.com/private/
+$ sysbox http-get -k https://self-signed.example.com/
+$ sysbox http-get -unix-socket /var/run/docker.sock http://localhost/version
+$ sysbox http-get -b cookies.txt -c cookies.txt https://example.com/login
+$ sysbox http-get -retry 5 -retry-delay 500ms https://flaky.example.com/
+$ sysbox http-get -F name=steve -F avatar=@me.png https://example.com/upload
+$ sysbox http-get -d @body.json -H 'Content-Type: application/json' https://api.example.com/`
 }
 
-// Execute is invoked if the user specifies `http-get` as the subcommand.
-func (hg *httpGetCommand) Execute(args []string) int {
+// addHeaders adds the user-specified headers to the given request.
+func (hg *httpGetCommand) addHeaders(req *http.Request) error {
+
+	for _, header := range hg.headers {
+
+		parts := strings.SplitN(header, ":", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid header '%s', expected 'Name: Value'", header)
+		}
+
+		name := strings.TrimSpace(parts[0])
+		value := strings.TrimSpace(parts[1])
+
+		// The host header is handled specially by the http package.
+		if strings.EqualFold(name, "Host") {
+			req.Host = value
+			continue
+		}
+		req.Header.Add(name, value)
+	}
 
-	// Ensure we have only a single URL
-	if len(args) != 1 {
-		fmt.Printf("Usage: http-get URL\n")
+	return nil
+}
+
+// addAuth adds basic-authentication to the given request, if the user
+// supplied credentials.
+func (hg *httpGetCommand) addAuth(req *http.Request) {
+
+	if hg.user == "" {
+		return
+	}
+
+	parts := strings.SplitN(hg.user, ":", 2)
+	if len(parts) == 2 {
+		req.SetBasicAuth(parts[0], parts[1])
+	} else {
+		req.SetBasicAuth(parts[0], "")
+	}
+}
+
+// promptPassword prompts for the user's password, without echoing it, if
+// they supplied only a username for basic-authentication.
+func (hg *httpGetCommand) promptPassword() error {
+
+	if hg.user == "" || strings.Contains(hg.user, ":") {
+		return nil
+	}
+
+	fmt.Fprintf(os.Stderr, "Enter password for %s: ", hg.user)
+	pass, err := terminal.ReadPassword(int(os.Stdin.Fd()))
+	fmt.Fprintf(os.Stderr, "\n")
+	if err != nil {
+		return fmt.Errorf("failed to read password: %s", err)
+	}
+
+	hg.user = hg.user + ":" + string(pass)
+	return nil
+}
+
+// client returns the HTTP client to make our request with.
+func (hg *httpGetCommand) client() *http.Client {
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DisableCompression = hg.raw
+	if hg.unixSocket != "" {
+		transport.Proxy = nil
+		transport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, "unix", hg.unixSocket)
+		}
+	}
+	if hg.insecure {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+
+	var roundTripper http.RoundTripper = transport
+	if hg.verbose {
+		roundTripper = &verboseTransport{transport: transport}
+	}
+
+	client := &http.Client{
+		Transport: roundTripper,
+		Timeout:   hg.timeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if hg.noRedirect {
+				return http.ErrUseLastResponse
+			}
+			if len(via) > hg.maxRedirects {
+				return fmt.Errorf("stopped after %d redirects", hg.maxRedirects)
+			}
+			return nil
+		},
+	}
+
+	if hg.jar != nil {
+		client.Jar = hg.jar
+	}
+	return client
+}
+
+// retryable returns true if the given response, or error, should be retried.
+//
+// Only timeouts, and connections which were refused or dropped, are
+// retried, since other errors, such as invalid certificates or too many
+// redirects, would fail the same way again.
+func (hg *httpGetCommand) retryable(response *http.Response, err error) bool {
+	if err == nil {
+		return response.StatusCode >= 500 || response.StatusCode == http.StatusTooManyRequests
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTemporary
+	}
+
+	return errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNABORTED) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// retryAfter returns the delay requested by the Retry-After header of the
+// given response, which may be a number of seconds or a date, or zero if
+// there is no such header.
+func (hg *httpGetCommand) retryAfter(response *http.Response) time.Duration {
+
+	value := response.Header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+
+	if secs, err := strconv.Atoi(value); err == nil && secs > 0 {
+		return time.Duration(secs) * time.Second
+	}
+
+	if when, err := http.ParseTime(value); err == nil {
+		return time.Until(when)
+	}
+
+	return 0
+}
+
+// do makes the given request, retrying it if it fails and the user asked
+// us to.
+func (hg *httpGetCommand) do(req *http.Request) (*http.Response, error) {
+
+	client := hg.client()
+	delay := hg.retryDelay
+
+	for attempt := 1; ; attempt++ {
+
+		// Rewind the body, if we're trying again.
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		response, err := client.Do(req)
+		if attempt > hg.retry || !hg.retryable(response, err) {
+			return response, err
+		}
+
+		// Work out why we failed, and how long to wait.
+		wait := delay
+		reason := ""
+		if err != nil {
+			reason = err.Error()
+		} else {
+			reason = response.Status
+			if after := hg.retryAfter(response); after > 0 {
+				wait = after
+			}
+			response.Body.Close()
+		}
+
+		fmt.Fprintf(os.Stderr, "attempt %d/%d failed: %s, retrying in %s\n", attempt, hg.retry+1, reason, wait)
+		time.Sleep(wait)
+		delay *= 2
+	}
+}
+
+// body fetches the given URL, returning the body of the response, which
+// must have a 200 status.  This is used by the commands which talk to
+// HTTP APIs, such as weather.
+func (hg *httpGetCommand) body(address string) ([]byte, error) {
+
+	req, err := http.NewRequest(http.MethodGet, address, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := hg.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	data, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s returned %s", response.Request.URL.Host, response.Status)
+	}
+	return data, nil
+}
+
+// requestBody returns a reader for the body of our request, if we have one,
+// along with the default Content-Type of that body.
+func (hg *httpGetCommand) requestBody() (io.Reader, string, error) {
+
+	if len(hg.form) > 0 {
+		if hg.data != "" {
+			return nil, "", fmt.Errorf("-d and -F are mutually exclusive")
+		}
+		return hg.formBody()
+	}
+
+	contentType := "application/x-www-form-urlencoded"
+
+	switch {
+	case hg.data == "":
+		return nil, "", nil
+	case hg.data == "-":
+		return os.Stdin, contentType, nil
+	case strings.HasPrefix(hg.data, "@"):
+		handle, err := os.Open(hg.data[1:])
+		if err != nil {
+			return nil, "", err
+		}
+		return handle, contentType, nil
+	}
+
+	return strings.NewReader(hg.data), contentType, nil
+}
+
+// formBody returns the body of a form built from the fields specified via
+// "-F", along with its Content-Type.  If any file is being uploaded the
+// form is sent as multipart/form-data, otherwise it is URL-encoded.
+func (hg *httpGetCommand) formBody() (io.Reader, string, error) {
+
+	multi := false
+	for _, field := range hg.form {
+		parts := strings.SplitN(field, "=", 2)
+		if len(parts) != 2 {
+			return nil, "", fmt.Errorf("invalid form field '%s', expected 'name=value'", field)
+		}
+		if strings.HasPrefix(parts[1], "@") {
+			multi = true
+		}
+	}
+
+	if !multi {
+		values := url.Values{}
+		for _, field := range hg.form {
+			parts := strings.SplitN(field, "=", 2)
+			values.Add(parts[0], parts[1])
+		}
+		return strings.NewReader(values.Encode()), "application/x-www-form-urlencoded", nil
+	}
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	for _, field := range hg.form {
+		parts := strings.SplitN(field, "=", 2)
+		name, value := parts[0], parts[1]
+
+		if !strings.HasPrefix(value, "@") {
+			if err := writer.WriteField(name, value); err != nil {
+				return nil, "", err
+			}
+			continue
+		}
+
+		file, err := os.Open(value[1:])
+		if err != nil {
+			return nil, "", err
+		}
+
+		part, err := writer.CreateFormFile(name, path.Base(value[1:]))
+		if err == nil {
+			_, err = io.Copy(part, file)
+		}
+		file.Close()
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, "", err
+	}
+
+	return &body, writer.FormDataContentType(), nil
+}
+
+// remoteFilename returns the name of the file we'd save the given URL to,
+// which is the final component of its path.
+func (hg *httpGetCommand) remoteFilename(u *url.URL) string {
+	name := path.Base(u.Path)
+	if name == "." || name == "/" {
+		name = "index.html"
+	}
+	return name
+}
+
+// remoteClash returns an error if any of the given URLs would be saved to
+// the same file, since they're fetched in parallel.
+func (hg *httpGetCommand) remoteClash(urls []string) error {
+
+	seen := make(map[string]string)
+	for _, rawURL := range urls {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			continue
+		}
+
+		name := hg.remoteFilename(u)
+		if prev, ok := seen[name]; ok {
+			return fmt.Errorf("%s and %s would both be saved to %s, fetch them separately with -o", prev, rawURL, name)
+		}
+		seen[name] = rawURL
+	}
+	return nil
+}
+
+// decodedBody is a reader which decompresses a response body, closing
+// both the decompressor and the body when it is closed.
+type decodedBody struct {
+	io.Reader
+	decoder io.Closer
+	body    io.Closer
+}
+
+// Close closes the decompressor and the underlying body.
+func (d *decodedBody) Close() error {
+	d.decoder.Close()
+	return d.body.Close()
+}
+
+// decode replaces the body of the given response with one which will
+// decompress it, if it has a Content-Encoding we understand.
+func (hg *httpGetCommand) decode(response *http.Response) error {
+
+	if hg.raw {
+		return nil
+	}
+
+	encoding := strings.ToLower(response.Header.Get("Content-Encoding"))
+	if encoding != "gzip" && encoding != "x-gzip" && encoding != "deflate" {
+		return nil
+	}
+
+	// There's nothing to decode in an empty body.
+	reader := bufio.NewReader(response.Body)
+	if _, err := reader.Peek(1); err == io.EOF {
+		return nil
+	}
+
+	var decoder io.ReadCloser
+	var err error
+
+	if encoding == "deflate" {
+		// This should be zlib-wrapped, but some servers send raw
+		// deflate data, so look at the header to decide.
+		header, _ := reader.Peek(2)
+		if len(header) == 2 && header[0]&0x0f == 8 && (uint(header[0])<<8|uint(header[1]))%31 == 0 {
+			decoder, err = zlib.NewReader(reader)
+		} else {
+			decoder = flate.NewReader(reader)
+		}
+	} else {
+		decoder, err = gzip.NewReader(reader)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	response.Body = &decodedBody{Reader: decoder, decoder: decoder, body: response.Body}
+	response.Header.Del("Content-Encoding")
+	response.Header.Del("Content-Length")
+	response.ContentLength = -1
+	return nil
+}
+
+// addCookies adds any cookies the user specified as "name=value" pairs to
+// our cookie-jar, for the given URL.
+func (hg *httpGetCommand) addCookies(u *url.URL) error {
+
+	// If there's no "=" this is the name of a cookie file, which
+	// has already been loaded.
+	if !strings.Contains(hg.cookies, "=") {
+		return nil
+	}
+
+	var cookies []*http.Cookie
+	for _, pair := range strings.Split(hg.cookies, ";") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid cookie '%s', expected 'name=value'", pair)
+		}
+		cookies = append(cookies, &http.Cookie{Name: parts[0], Value: parts[1], Path: "/"})
+	}
+
+	hg.jar.SetCookies(u, cookies)
+	return nil
+}
+
+// isJSON returns true if the given response should be pretty-printed as
+// JSON.
+func (hg *httpGetCommand) isJSON(response *http.Response) bool {
+	if hg.json {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(response.Header.Get("Content-Type"))
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// showHeaders writes the status-line, and the headers, of the given
+// response to the writer, sorted by name.
+func (hg *httpGetCommand) showHeaders(out io.Writer, response *http.Response) {
+	fmt.Fprintf(out, "%s %s\n", response.Proto, response.Status)
+	writeHeaders(out, "", response.Header)
+}
+
+// save writes the body of the response to the named file, appending to it
+// rather than replacing it if requested.
+func (hg *httpGetCommand) save(response *http.Response, filename string, appending bool) error {
+
+	flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if appending {
+		flags = os.O_WRONLY | os.O_APPEND
+	}
+
+	file, err := os.OpenFile(filename, flags, 0666)
+	if err != nil {
+		return err
+	}
+
+	// Show our progress, if STDERR is a terminal.
+	var dst io.Writer = file
+	if !hg.quiet && terminal.IsTerminal(int(os.Stderr.Fd())) {
+		progress := &progressWriter{total: response.ContentLength, start: time.Now()}
+		dst = io.MultiWriter(file, progress)
+		defer progress.finish()
+	}
+
+	_, err = io.Copy(dst, response.Body)
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// fetchAll fetches each of the given URLs, using a pool of workers, and
+// returns non-zero if any of them failed.
+func (hg *httpGetCommand) fetchAll(urls []string) int {
+
+	jobs := make(chan string)
+	failed := 0
+
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+
+	for i := 0; i < hg.jobs; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for u := range jobs {
+
+				// Buffer the output so that it isn't interleaved.
+				var out bytes.Buffer
+				rc := hg.fetch(u, &out)
+
+				mutex.Lock()
+				if out.Len() > 0 {
+					fmt.Printf("==> %s <==\n%s", u, out.String())
+				}
+				if rc != 0 {
+					failed++
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	for _, u := range urls {
+		jobs <- u
+	}
+	close(jobs)
+	wg.Wait()
+
+	if failed > 0 {
+		fmt.Printf("error: %d of %d URLs failed\n", failed, len(urls))
+		return 1
+	}
+	return 0
+}
+
+// fetch makes our request to the given URL, writing the output to the
+// given writer, and returns the exit-code.
+func (hg *httpGetCommand) fetch(rawURL string, out io.Writer) int {
+
+	// Get the body of the request, if any.
+	body, contentType, err := hg.requestBody()
+	if err != nil {
+		fmt.Fprintf(out, "error: %s\n", err.Error())
+		return 1
+	}
+
+	// If we might retry then we must be able to send the body again,
+	// so read it into memory.
+	if body != nil && hg.retry > 0 {
+		data, err := ioutil.ReadAll(body)
+
+		// Close the file given via "-d @file", now we've read it.
+		if file, ok := body.(*os.File); ok && file != os.Stdin {
+			file.Close()
+		}
+		if err != nil {
+			fmt.Fprintf(out, "error: %s\n", err.Error())
+			return 1
+		}
+		body = bytes.NewReader(data)
+	}
+
+	// Default to POST if we have a body, like curl.
+	method := strings.ToUpper(hg.method)
+	if hg.head {
+		if method != "" && method != "HEAD" {
+			fmt.Fprintf(out, "error: -I may only be used with HEAD requests\n")
+			return 1
+		}
+		method = "HEAD"
+	}
+	if method == "" {
+		method = "GET"
+		if body != nil {
+			method = "POST"
+		}
+	}
+
+	// Ensure the method is one we know
+	switch method {
+	case "GET", "POST", "PUT", "DELETE", "PATCH", "HEAD", "OPTIONS":
+	default:
+		fmt.Fprintf(out, "error: unknown HTTP method '%s'\n", hg.method)
+		return 1
+	}
+
+	// Create the request
+	req, err := http.NewRequest(method, rawURL, body)
+	if err != nil {
+		fmt.Fprintf(out, "error: %s\n", err.Error())
 		return 1
 	}
 
+	// We can send the length of a file, avoiding a chunked upload.
+	if file, ok := body.(*os.File); ok && file != os.Stdin {
+		if info, err := file.Stat(); err == nil {
+			req.ContentLength = info.Size()
+		}
+	}
+
+	// Add any headers
+	err = hg.addHeaders(req)
+	if err != nil {
+		fmt.Fprintf(out, "error: %s\n", err.Error())
+		return 1
+	}
+
+	// Add any credentials
+	hg.addAuth(req)
+
+	// Add any cookies
+	if hg.cookies != "" {
+		err = hg.addCookies(req.URL)
+		if err != nil {
+			fmt.Fprintf(out, "error: %s\n", err.Error())
+			return 1
+		}
+	}
+
+	// Default the content-type, if the user didn't specify one.
+	if body != nil && req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	// Work out where we're saving the response, if anywhere.
+	output := hg.output
+	if hg.remoteName {
+		output = hg.remoteFilename(req.URL)
+	}
+
+	// If we're resuming a download only request what we're missing.
+	offset := int64(0)
+	if hg.resume && output != "" {
+		if info, err := os.Stat(output); err == nil && info.Size() > 0 {
+			offset = info.Size()
+			req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		}
+	}
+
 	// Make the request
-	response, err := http.Get(args[0])
+	response, err := hg.do(req)
 	if err != nil {
-		fmt.Printf("error: %s", err.Error())
+		fmt.Fprintf(out, "error: %s\n", err.Error())
+		return 1
+	}
+
+	// Decompress the body, if necessary.
+	err = hg.decode(response)
+	if err != nil {
+		response.Body.Close()
+		fmt.Fprintf(out, "error: %s\n", err.Error())
 		return 1
 	}
 
-	// Get the body.
 	defer response.Body.Close()
+
+	// Show only the status code?
+	if hg.status {
+		fmt.Fprintf(out, "%d\n", response.StatusCode)
+		return 0
+	}
+
+	// Fail on errors?
+	if hg.fail && response.StatusCode >= 400 {
+		fmt.Fprintf(os.Stderr, "error: %s\n", response.Status)
+		return 1
+	}
+
+	// Show only the headers?
+	if hg.head {
+		hg.showHeaders(out, response)
+		return 0
+	}
+
+	// Show a redirect, rather than the body, if we didn't follow it.
+	if hg.noRedirect && response.StatusCode >= 300 && response.StatusCode < 400 {
+		if hg.include {
+			hg.showHeaders(out, response)
+		} else {
+			fmt.Fprintf(out, "%s %s\n", response.Proto, response.Status)
+			fmt.Fprintf(out, "Location: %s\n", response.Header.Get("Location"))
+		}
+		return 0
+	}
+
+	// Show the headers before the body?
+	if hg.include {
+		hg.showHeaders(out, response)
+		fmt.Fprintf(out, "\n")
+	}
+
+	// Saving to a file?
+	if output != "" {
+
+		// If we're resuming ensure the server sent what we asked for,
+		// and download the whole file again if it didn't.
+		appending := false
+		if offset > 0 {
+			switch response.StatusCode {
+			case http.StatusPartialContent:
+				if !strings.HasPrefix(response.Header.Get("Content-Range"), fmt.Sprintf("bytes %d-", offset)) {
+					fmt.Fprintf(out, "error: unexpected Content-Range '%s'\n", response.Header.Get("Content-Range"))
+					return 1
+				}
+				appending = true
+			case http.StatusRequestedRangeNotSatisfiable:
+				fmt.Fprintf(os.Stderr, "%s is already complete\n", output)
+				return 0
+			case http.StatusOK:
+				fmt.Fprintf(os.Stderr, "server doesn't support resuming, downloading %s again\n", output)
+			default:
+				fmt.Fprintf(out, "error: %s\n", response.Status)
+				return 1
+			}
+		}
+
+		err = hg.save(response, output, appending)
+		if err != nil {
+			fmt.Fprintf(out, "error: %s\n", err.Error())
+			return 1
+		}
+		if response.StatusCode >= 400 {
+			fmt.Fprintf(out, "error: %s\n", response.Status)
+			return 1
+		}
+		return 0
+	}
+
+	// Get the body.
 	contents, err := ioutil.ReadAll(response.Body)
 	if err != nil {
-		fmt.Printf("error: %s", err.Error())
+		fmt.Fprintf(out, "error: %s\n", err.Error())
+		return 1
+	}
+
+	// Pretty-print JSON, falling back to the raw body if it is invalid.
+	if hg.isJSON(response) {
+		var out bytes.Buffer
+		err = json.Indent(&out, contents, "", "  ")
+		if err == nil {
+			contents = out.Bytes()
+		} else {
+			fmt.Fprintf(os.Stderr, "warning: invalid JSON: %s\n", err.Error())
+		}
+	}
+
+	fmt.Fprintf(out, "%s\n", string(contents))
+
+	// If we ran out of retries then we've failed.
+	if hg.retry > 0 && hg.retryable(response, nil) {
 		return 1
 	}
 
 	// All OK
-	fmt.Printf("%s\n", string(contents))
 	return 0
 }
+
+// Execute is invoked if the user specifies `http-get` as the subcommand.
+func (hg *httpGetCommand) Execute(args []string) int {
+
+	// Ensure we have at least one URL
+	if len(args) < 1 {
+		fmt.Printf("Usage: http-get [-X METHOD] URL [URL ...]\n")
+		return 1
+	}
+
+	if hg.output != "" && hg.remoteName {
+		fmt.Printf("error: -o and -O are mutually exclusive\n")
+		return 1
+	}
+
+	if hg.resume && hg.output == "" && !hg.remoteName {
+		fmt.Printf("error: -continue may only be used with -o or -O\n")
+		return 1
+	}
+
+	if hg.jobs < 1 {
+		fmt.Printf("error: -j must be at least 1\n")
+		return 1
+	}
+
+	// Some options only make sense with a single URL.
+	if len(args) > 1 {
+		if hg.output != "" {
+			fmt.Printf("error: -o may only be used with a single URL, use -O instead\n")
+			return 1
+		}
+		if hg.data == "-" {
+			fmt.Printf("error: -d - may only be used with a single URL\n")
+			return 1
+		}
+
+		if hg.remoteName {
+			if err := hg.remoteClash(args); err != nil {
+				fmt.Printf("error: %s\n", err.Error())
+				return 1
+			}
+		}
+		hg.quiet = true
+	}
+
+	// Prompt for the password, if we need to.
+	err := hg.promptPassword()
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+
+	// Setup our cookies, loading them from a file if we were given one.
+	if hg.cookies != "" || hg.saveCookies != "" {
+		hg.jar = newCookieJar()
+		if hg.cookies != "" && !strings.Contains(hg.cookies, "=") {
+			err = hg.jar.load(hg.cookies)
+			if err != nil {
+				fmt.Printf("error: %s\n", err.Error())
+				return 1
+			}
+		}
+	}
+
+	ret := 0
+	if len(args) == 1 {
+		ret = hg.fetch(args[0], os.Stdout)
+	} else {
+		ret = hg.fetchAll(args)
+	}
+
+	// Save any cookies we received.
+	if hg.saveCookies != "" {
+		err = hg.jar.save(hg.saveCookies)
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			return 1
+		}
+	}
+
+	return ret
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestHTTPGetRetryable tests which failures are retried.
+func TestHTTPGetRetryable(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/loop":
+			http.Redirect(w, r, "/loop", http.StatusFound)
+		case "/slow":
+			time.Sleep(200 * time.Millisecond)
+		case "/busy":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case "/limited":
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	secure := httptest.NewTLSServer(http.NotFoundHandler())
+	defer secure.Close()
+
+	// Find a port nothing is listening upon.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %s", err)
+	}
+	refused := "http://" + listener.Addr().String() + "/"
+	listener.Close()
+
+	tests := []struct {
+		url       string
+		retryable bool
+	}{
+		{server.URL + "/busy", true},
+		{server.URL + "/limited", true},
+		{server.URL + "/missing", false},
+		{server.URL + "/slow", true},
+		{server.URL + "/loop", false},
+		{secure.URL + "/", false},
+		{refused, true},
+	}
+
+	hg := &httpGetCommand{maxRedirects: 2, timeout: 100 * time.Millisecond}
+	client := hg.client()
+
+	for _, test := range tests {
+		response, err := client.Get(test.url)
+		if err == nil {
+			response.Body.Close()
+		}
+		if hg.retryable(response, err) != test.retryable {
+			t.Fatalf("%s gave %v, expected retryable to be %v", test.url, err, test.retryable)
+		}
+	}
+}
+
+// TestHTTPGetRemoteClash tests that URLs saved to the same file via -O
+// are rejected.
+func TestHTTPGetRemoteClash(t *testing.T) {
+
+	tests := []struct {
+		urls  []string
+		clash bool
+	}{
+		{[]string{"https://example.com/a/x.tar", "https://example.com/b/y.tar"}, false},
+		{[]string{"https://example.com/a/x.tar", "https://example.org/b/x.tar"}, true},
+		{[]string{"https://example.com/", "https://example.org"}, true},
+		{[]string{"https://example.com/x.tar", "https://example.com/x.tar.gz"}, false},
+	}
+
+	hg := &httpGetCommand{}
+	for _, test := range tests {
+		err := hg.remoteClash(test.urls)
+		if (err != nil) != test.clash {
+			t.Fatalf("%v gave %v, expected a clash to be %v", test.urls, err, test.clash)
+		}
+	}
+}
@@ -1,10 +1,12 @@
 package main
 
 import (
+	"crypto/subtle"
 	"flag"
 	"fmt"
 	"log"
 	"net/http"
+	"strings"
 )
 
 // Structure for our options and state.
@@ -12,14 +14,24 @@ type httpdCommand struct {
 	host string
 	port int
 	path string
+
+	// Add permissive CORS headers to our responses?
+	cors bool
+
+	// The username and password required, as "user:pass", if any.
+	auth string
 }
 
 // Arguments adds per-command args to the object.
 func (h *httpdCommand) Arguments(f *flag.FlagSet) {
 
 	f.StringVar(&h.path, "path", ".", "The directory to use as the HTTP root directory")
+	f.StringVar(&h.path, "d", ".", "Alias for -path")
 	f.StringVar(&h.host, "host", "127.0.0.1", "The host to bind upon (use 0.0.0.0 for remote access)")
 	f.IntVar(&h.port, "port", 3000, "The port to listen upon")
+	f.IntVar(&h.port, "p", 3000, "Alias for -port")
+	f.BoolVar(&h.cors, "cors", false, "Add permissive CORS headers to all responses")
+	f.StringVar(&h.auth, "auth", "", "Require HTTP basic-authentication, with the given 'user:pass'")
 
 }
 
@@ -35,13 +47,27 @@ the contents found beneath the current working directory.
 By default the content is served to the localhost only, but that can
 be changed.
 
+Directory listings are shown for directories without an index.html
+file, and each request is logged to STDERR along with its status-code.
+
+The '-cors' flag adds headers allowing the content to be fetched from
+any origin, which is useful when developing web applications, and
+'-auth' requires a username and password via HTTP basic-authentication.
+
+This command may also be invoked as 'serve', and '-d' and '-p' may be
+used as short forms of '-path' and '-port'.
+
 Examples:
 
 $ sysbox httpd
 2020/04/01 21:36:27 Serving upon http://127.0.0.1:3000/
 
 $ sysbox httpd -host=0.0.0.0 -port 8080
-2020/04/01 21:36:45 Serving upon http://0.0.0.0:8080/`
+2020/04/01 21:36:45 Serving upon http://0.0.0.0:8080/
+
+$ sysbox httpd -cors -auth user:secret
+
+$ sysbox serve -d ./public -p 8000`
 
 }
 
@@ -52,8 +78,20 @@ func (h *httpdCommand) Execute(args []string) int {
 	// Create a static-file server, based upon the
 	// path we're treating as our root-directory.
 	//
-	fs := http.FileServer(http.Dir(h.path))
-	http.Handle("/", fs)
+	var handler http.Handler
+	handler = http.FileServer(http.Dir(h.path))
+
+	if h.auth != "" {
+		if !strings.Contains(h.auth, ":") {
+			fmt.Printf("error: -auth must be of the form 'user:pass'\n")
+			return 1
+		}
+		handler = h.requireAuth(handler)
+	}
+	if h.cors {
+		handler = addCORS(handler)
+	}
+	http.Handle("/", handler)
 
 	//
 	// Build up the listen address.
@@ -64,17 +102,74 @@ func (h *httpdCommand) Execute(args []string) int {
 	// Log our start, and begin serving.
 	//
 	log.Printf("Serving upon http://%s/\n", listen)
-	http.ListenAndServe(listen, logRequest(http.DefaultServeMux))
+	err := http.ListenAndServe(listen, logRequest(http.DefaultServeMux))
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
 	return 0
 }
 
-// logRequest dumps the request to the console.
-//
-// Of course we don't know the return-code, but this is good enough
-// for most of my use-cases.
-func logRequest(handler http.Handler) http.Handler {
+// serveCommand allows httpd to be invoked as serve.
+type serveCommand struct {
+	httpdCommand
+}
+
+// Info returns the name of this subcommand.
+func (s *serveCommand) Info() (string, string) {
+	_, help := s.httpdCommand.Info()
+	return "serve", help
+}
+
+// requireAuth wraps the handler, requiring the user to authenticate
+// with the username and password we were given.
+func (h *httpdCommand) requireAuth(handler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		log.Printf("%s %s %s\n", r.RemoteAddr, r.Method, r.URL)
+		user, pass, ok := r.BasicAuth()
+		if !ok || subtle.ConstantTimeCompare([]byte(user+":"+pass), []byte(h.auth)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Basic realm="sysbox"`)
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
 		handler.ServeHTTP(w, r)
 	})
 }
+
+// addCORS wraps the handler, adding headers which allow requests
+// from any origin.
+func addCORS(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, HEAD, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "*")
+
+		// Preflight requests need no further response.
+		if r.Method == http.MethodOptions {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// statusWriter records the status-code of the response it wraps.
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+// WriteHeader records the status-code, before sending it.
+func (s *statusWriter) WriteHeader(status int) {
+	s.status = status
+	s.ResponseWriter.WriteHeader(status)
+}
+
+// logRequest dumps the request, and the status-code of the response,
+// to the console.
+func logRequest(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+		handler.ServeHTTP(sw, r)
+		log.Printf("%s %s %s %d\n", r.RemoteAddr, r.Method, r.URL, sw.status)
+	})
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/big"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// Structure for our options and state.
+type ipcalcCommand struct {
+
+	// Split the network into subnets with this prefix length.
+	split string
+}
+
+// Arguments adds per-command args to the object.
+func (i *ipcalcCommand) Arguments(f *flag.FlagSet) {
+	f.StringVar(&i.split, "split", "", "Split the network into subnets of the given prefix length, e.g. '/26'")
+}
+
+// Info returns the name of this subcommand.
+func (i *ipcalcCommand) Info() (string, string) {
+	return "ipcalc", `Calculate the details of IP networks.
+
+Details:
+
+Given a network in CIDR notation, such as '192.168.1.0/24', this command
+shows the network address, netmask, wildcard mask, broadcast address,
+the range of usable host addresses, and the number of hosts.  Both IPv4
+and IPv6 networks are supported.
+
+With '-split' the network is divided into subnets with the given prefix
+length, and each of them is listed.
+
+If given an address and a network the command tests whether the address
+is within the network, returning a zero exit-code if it is, and a
+non-zero exit-code if it isn't.
+
+Examples:
+
+$ sysbox ipcalc 192.168.1.0/24
+$ sysbox ipcalc 2001:db8::/48
+$ sysbox ipcalc -split /26 10.0.0.0/24
+$ sysbox ipcalc 10.0.0.5 10.0.0.0/8 && echo "internal"`
+}
+
+// parseNetwork parses an address, or a network in CIDR notation.
+func parseNetwork(spec string) (net.IP, *net.IPNet, error) {
+
+	if !strings.Contains(spec, "/") {
+		ip := net.ParseIP(spec)
+		if ip == nil {
+			return nil, nil, fmt.Errorf("invalid address '%s'", spec)
+		}
+		bits := 128
+		if ip.To4() != nil {
+			ip = ip.To4()
+			bits = 32
+		}
+		return ip, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+	}
+
+	ip, network, err := net.ParseCIDR(spec)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ip.To4() != nil {
+		ip = ip.To4()
+	}
+	return ip, network, nil
+}
+
+// ipToInt returns the address as an integer.
+func ipToInt(ip net.IP) *big.Int {
+	return new(big.Int).SetBytes(ip)
+}
+
+// intToIP returns the integer as an address of the given length.
+func intToIP(n *big.Int, length int) net.IP {
+	ip := make(net.IP, length)
+	b := n.Bytes()
+	copy(ip[length-len(b):], b)
+	return ip
+}
+
+// show outputs the details of the given network.
+func (i *ipcalcCommand) show(ip net.IP, network *net.IPNet) {
+
+	ones, bits := network.Mask.Size()
+	length := len(network.IP)
+
+	first := ipToInt(network.IP)
+	size := new(big.Int).Lsh(big.NewInt(1), uint(bits-ones))
+	last := new(big.Int).Sub(new(big.Int).Add(first, size), big.NewInt(1))
+
+	wildcard := make(net.IP, length)
+	for n := range wildcard {
+		wildcard[n] = ^network.Mask[n]
+	}
+
+	fmt.Printf("Address:   %s\n", ip)
+	fmt.Printf("Network:   %s\n", network)
+	fmt.Printf("Netmask:   %s\n", net.IP(network.Mask))
+	fmt.Printf("Wildcard:  %s\n", wildcard)
+
+	// IPv4 networks, other than point-to-point links, reserve the
+	// first and last addresses.
+	hostMin, hostMax, hosts := first, last, size
+	if bits == 32 && bits-ones >= 2 {
+		fmt.Printf("Broadcast: %s\n", intToIP(last, length))
+		hostMin = new(big.Int).Add(first, big.NewInt(1))
+		hostMax = new(big.Int).Sub(last, big.NewInt(1))
+		hosts = new(big.Int).Sub(size, big.NewInt(2))
+	}
+
+	fmt.Printf("HostMin:   %s\n", intToIP(hostMin, length))
+	fmt.Printf("HostMax:   %s\n", intToIP(hostMax, length))
+	fmt.Printf("Hosts:     %s\n", hosts)
+}
+
+// subnets lists the subnets of the network, with the given prefix length.
+func (i *ipcalcCommand) subnets(network *net.IPNet) error {
+
+	prefix, err := strconv.Atoi(strings.TrimPrefix(i.split, "/"))
+	ones, bits := network.Mask.Size()
+	if err != nil || prefix < ones || prefix > bits {
+		return fmt.Errorf("invalid prefix length '%s', it must be between /%d and /%d", i.split, ones, bits)
+	}
+	if prefix-ones > 16 {
+		return fmt.Errorf("splitting %s into /%d networks would result in too many subnets", network, prefix)
+	}
+
+	count := 1 << uint(prefix-ones)
+	step := new(big.Int).Lsh(big.NewInt(1), uint(bits-prefix))
+	current := ipToInt(network.IP)
+
+	for n := 0; n < count; n++ {
+		fmt.Printf("%s/%d\n", intToIP(current, len(network.IP)), prefix)
+		current.Add(current, step)
+	}
+	return nil
+}
+
+// Execute is invoked if the user specifies `ipcalc` as the subcommand.
+func (i *ipcalcCommand) Execute(args []string) int {
+
+	switch len(args) {
+	case 1:
+		ip, network, err := parseNetwork(args[0])
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			return 1
+		}
+
+		if i.split != "" {
+			err = i.subnets(network)
+			if err != nil {
+				fmt.Printf("error: %s\n", err.Error())
+				return 1
+			}
+			return 0
+		}
+
+		i.show(ip, network)
+		return 0
+
+	case 2:
+		ip := net.ParseIP(args[0])
+		if ip == nil {
+			fmt.Printf("error: invalid address '%s'\n", args[0])
+			return 1
+		}
+		_, network, err := parseNetwork(args[1])
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			return 1
+		}
+
+		if network.Contains(ip) {
+			fmt.Printf("%s is within %s\n", ip, network)
+			return 0
+		}
+		fmt.Printf("%s is not within %s\n", ip, network)
+		return 1
+	}
+
+	fmt.Printf("Usage: ipcalc [-split /N] network/prefix\n")
+	fmt.Printf("       ipcalc address network/prefix\n")
+	return 1
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// Structure for our options and state.
+type ipinfoCommand struct {
+
+	// Show the raw JSON response?
+	json bool
+
+	// The single field to show, if any.
+	field string
+
+	// The maximum time each request may take.
+	timeout time.Duration
+}
+
+// ipinfoResult is the response of the geolocation API.
+type ipinfoResult struct {
+	IP       string `json:"ip"`
+	Hostname string `json:"hostname"`
+	City     string `json:"city"`
+	Region   string `json:"region"`
+	Country  string `json:"country"`
+	Location string `json:"loc"`
+	Org      string `json:"org"`
+	Postal   string `json:"postal"`
+	Timezone string `json:"timezone"`
+	Bogon    bool   `json:"bogon"`
+}
+
+var (
+	// ipinfoEchoURL is the service used to find our public IP address.
+	ipinfoEchoURL = "https://api.ipify.org"
+
+	// ipinfoURL is the geolocation API.
+	ipinfoURL = "https://ipinfo.io/"
+)
+
+// ipinfoFields are the fields we show, in order.
+var ipinfoFields = []string{"ip", "hostname", "city", "region", "country", "location", "asn", "org", "postal", "timezone"}
+
+// Arguments adds per-command args to the object.
+func (i *ipinfoCommand) Arguments(f *flag.FlagSet) {
+	f.BoolVar(&i.json, "json", false, "Show the raw JSON response from the API")
+	f.StringVar(&i.field, "field", "", "Show only the given field, such as 'country' or 'asn'")
+	f.DurationVar(&i.timeout, "timeout", 30*time.Second, "The maximum time each request may take")
+}
+
+// Info returns the name of this subcommand.
+func (i *ipinfoCommand) Info() (string, string) {
+	return "ipinfo", `Show the location, and owner, of an IP address.
+
+Details:
+
+This command shows the country, city, ASN, and organization of the given
+IP address, or hostname, as reported by the ipinfo.io API.  If no address
+is given then your public IP address is found, via api.ipify.org, and the
+details of that are shown instead.
+
+The fields shown are:
+
+   ip, hostname, city, region, country, location, asn, org, postal, and
+   timezone.
+
+The '-field' flag shows just one of them, which is useful in scripts,
+and the '-json' flag shows the raw response from the API.
+
+The API limits the number of requests which may be made without an
+account, if you have one your token may be set in $IPINFO_TOKEN.
+
+Examples:
+
+$ sysbox ipinfo
+$ sysbox ipinfo 8.8.8.8
+$ sysbox ipinfo -field country 1.1.1.1`
+}
+
+// value returns the named field of the result.
+func (i *ipinfoCommand) value(result ipinfoResult, field string) string {
+
+	// The organization is prefixed by its ASN, as "AS15169 Google LLC".
+	asn, org := "", result.Org
+	if strings.HasPrefix(org, "AS") {
+		asn = org
+		if n := strings.Index(org, " "); n > 0 {
+			asn, org = org[:n], org[n+1:]
+		}
+	}
+
+	switch field {
+	case "ip":
+		return result.IP
+	case "hostname":
+		return result.Hostname
+	case "city":
+		return result.City
+	case "region":
+		return result.Region
+	case "country":
+		return result.Country
+	case "location":
+		return result.Location
+	case "asn":
+		return asn
+	case "org":
+		return org
+	case "postal":
+		return result.Postal
+	case "timezone":
+		return result.Timezone
+	}
+	return ""
+}
+
+// Execute is invoked if the user specifies `ipinfo` as the subcommand.
+func (i *ipinfoCommand) Execute(args []string) int {
+
+	if len(args) > 1 {
+		fmt.Printf("Usage: ipinfo [-json] [-field name] [ip|hostname]\n")
+		return 1
+	}
+
+	if i.field != "" {
+		known := false
+		for _, field := range ipinfoFields {
+			if field == i.field {
+				known = true
+			}
+		}
+		if !known {
+			fmt.Printf("error: unknown field '%s', valid fields are %s\n", i.field, strings.Join(ipinfoFields, ", "))
+			return 1
+		}
+	}
+
+	// Reuse the plumbing of http-get, for its timeout and retries.
+	hg := &httpGetCommand{timeout: i.timeout, maxRedirects: 10, retry: 2, retryDelay: time.Second}
+
+	var address string
+	if len(args) == 0 {
+		data, err := hg.body(ipinfoEchoURL)
+		if err != nil {
+			fmt.Printf("error: failed to find your public IP address: %s\n", err.Error())
+			return 1
+		}
+		address = strings.TrimSpace(string(data))
+	} else {
+		address = args[0]
+	}
+
+	// Hostnames are looked up, using their first address.
+	ip := net.ParseIP(address)
+	if ip == nil {
+		ips, err := net.LookupIP(address)
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			return 1
+		}
+		ip = ips[0]
+	}
+
+	query := ipinfoURL + url.PathEscape(ip.String()) + "/json"
+	if token := os.Getenv("IPINFO_TOKEN"); token != "" {
+		query += "?token=" + url.QueryEscape(token)
+	}
+
+	data, err := hg.body(query)
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+
+	if i.json {
+		fmt.Printf("%s\n", strings.TrimSpace(string(data)))
+		return 0
+	}
+
+	var result ipinfoResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		fmt.Printf("error: failed to parse the response: %s\n", err.Error())
+		return 1
+	}
+	if result.Bogon {
+		fmt.Printf("error: %s is a private, or reserved, address\n", ip.String())
+		return 1
+	}
+
+	if i.field != "" {
+		fmt.Println(i.value(result, i.field))
+		return 0
+	}
+
+	for _, field := range ipinfoFields {
+		if value := i.value(result, field); value != "" {
+			fmt.Printf("%-9s %s\n", field+":", value)
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,263 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Structure for our options and state.
+type jsonCommand struct {
+
+	// The number of spaces to indent by.
+	indent int
+
+	// Output minified JSON?
+	compact bool
+
+	// Only validate the input?
+	validate bool
+}
+
+// Arguments adds per-command args to the object.
+func (j *jsonCommand) Arguments(f *flag.FlagSet) {
+	f.IntVar(&j.indent, "indent", 2, "The number of spaces to indent by")
+	f.BoolVar(&j.compact, "compact", false, "Output minified JSON")
+	f.BoolVar(&j.validate, "validate", false, "Only validate the input, showing no output")
+}
+
+// Info returns the name of this subcommand.
+func (j *jsonCommand) Info() (string, string) {
+	return "json", `Pretty-print, minify, or validate JSON.
+
+Details:
+
+This command reads JSON from the named file, or STDIN if no file is
+given, and pretty-prints it.  The indentation may be changed via
+'-indent', or the output minified via '-compact'.
+
+The input is processed as a stream, so large documents may be handled,
+and the order of keys and the precision of numbers are preserved.  If
+the input contains several JSON values each is output in turn.
+
+The '-validate' flag will only check that the input is well-formed,
+showing nothing unless there is an error.
+
+In all cases a syntax error will result in a non-zero exit-code, along
+with the offset of the problem.
+
+Examples:
+
+$ echo '{"a":[1,2]}' | sysbox json
+$ sysbox json -compact data.json
+$ sysbox json -validate data.json`
+}
+
+// jsonLevel holds the state of an object, or array, we're inside.
+type jsonLevel struct {
+
+	// Is this an object, rather than an array?
+	object bool
+
+	// The number of members we've seen.
+	count int
+
+	// Are we expecting an object-key next?
+	key bool
+}
+
+// jsonFormatter writes a stream of JSON tokens as formatted JSON.
+type jsonFormatter struct {
+
+	// Where we write our output.
+	out *bufio.Writer
+
+	// The indentation to use, empty for compact output.
+	indent string
+
+	// The objects, and arrays, we're inside.
+	stack []*jsonLevel
+}
+
+// newline writes a newline, and indentation, when pretty-printing.
+func (f *jsonFormatter) newline() {
+	if f.indent != "" {
+		f.out.WriteString("\n" + strings.Repeat(f.indent, len(f.stack)))
+	}
+}
+
+// str writes the given string, JSON-encoded.
+func (f *jsonFormatter) str(s string) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.Encode(s)
+	f.out.Write(bytes.TrimSuffix(buf.Bytes(), []byte("\n")))
+}
+
+// before prepares for a value to be written, adding any separator.
+func (f *jsonFormatter) before() {
+	if len(f.stack) == 0 {
+		return
+	}
+	top := f.stack[len(f.stack)-1]
+	if !top.object {
+		if top.count > 0 {
+			f.out.WriteString(",")
+		}
+		f.newline()
+	}
+}
+
+// after records that a value has been written.
+func (f *jsonFormatter) after() {
+	if len(f.stack) == 0 {
+		f.out.WriteString("\n")
+		return
+	}
+	top := f.stack[len(f.stack)-1]
+	top.count++
+	if top.object {
+		top.key = true
+	}
+}
+
+// token writes the given token.
+func (f *jsonFormatter) token(tok json.Token) {
+
+	// Object keys are handled specially.
+	if len(f.stack) > 0 {
+		top := f.stack[len(f.stack)-1]
+		if key, ok := tok.(string); ok && top.object && top.key {
+			if top.count > 0 {
+				f.out.WriteString(",")
+			}
+			f.newline()
+			f.str(key)
+			f.out.WriteString(":")
+			if f.indent != "" {
+				f.out.WriteString(" ")
+			}
+			top.key = false
+			return
+		}
+	}
+
+	switch t := tok.(type) {
+	case json.Delim:
+		switch t {
+		case '{', '[':
+			f.before()
+			f.out.WriteString(t.String())
+			f.stack = append(f.stack, &jsonLevel{object: t == '{', key: t == '{'})
+		case '}', ']':
+			top := f.stack[len(f.stack)-1]
+			f.stack = f.stack[:len(f.stack)-1]
+			if top.count > 0 {
+				f.newline()
+			}
+			f.out.WriteString(t.String())
+			f.after()
+		}
+		return
+	case string:
+		f.before()
+		f.str(t)
+	case json.Number:
+		f.before()
+		f.out.WriteString(t.String())
+	case bool:
+		f.before()
+		fmt.Fprintf(f.out, "%t", t)
+	case nil:
+		f.before()
+		f.out.WriteString("null")
+	}
+	f.after()
+}
+
+// process reads JSON from the given reader, writing it formatted to the
+// writer, or nowhere if we're only validating.
+func (j *jsonCommand) process(in io.Reader, out io.Writer) error {
+
+	dec := json.NewDecoder(bufio.NewReader(in))
+	dec.UseNumber()
+
+	formatter := &jsonFormatter{out: bufio.NewWriter(out)}
+	if !j.compact {
+		formatter.indent = strings.Repeat(" ", j.indent)
+	}
+	defer formatter.out.Flush()
+
+	depth := 0
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			if syntax, ok := err.(*json.SyntaxError); ok {
+				return fmt.Errorf("%s, at byte offset %d", err.Error(), syntax.Offset)
+			}
+			if err == io.ErrUnexpectedEOF {
+				return fmt.Errorf("unexpected end of input, at byte offset %d", dec.InputOffset())
+			}
+			return err
+		}
+
+		// Track our depth, as the decoder doesn't complain about
+		// unterminated objects, or arrays, at the end of the input.
+		if delim, ok := tok.(json.Delim); ok {
+			if delim == '{' || delim == '[' {
+				depth++
+			} else {
+				depth--
+			}
+		}
+
+		if !j.validate {
+			formatter.token(tok)
+		}
+	}
+
+	if depth > 0 {
+		return fmt.Errorf("unexpected end of input, at byte offset %d", dec.InputOffset())
+	}
+	return nil
+}
+
+// Execute is invoked if the user specifies `json` as the subcommand.
+func (j *jsonCommand) Execute(args []string) int {
+
+	if len(args) > 1 {
+		fmt.Printf("Usage: json [file]\n")
+		return 1
+	}
+	if j.indent < 0 {
+		fmt.Printf("error: -indent must not be negative\n")
+		return 1
+	}
+
+	var in io.Reader = os.Stdin
+	if len(args) == 1 && args[0] != "-" {
+		file, err := os.Open(args[0])
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			return 1
+		}
+		defer file.Close()
+		in = file
+	}
+
+	err := j.process(in, os.Stdout)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,231 @@
+package main
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"hash"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+)
+
+// Structure for our options and state.
+type jwtCommand struct {
+
+	// Verify the signature, and validity period, of the token?
+	verify bool
+
+	// The secret used to verify the signature.
+	secret string
+}
+
+// jwtAlgorithms holds the HMAC algorithms we can verify.
+var jwtAlgorithms = map[string]func() hash.Hash{
+	"HS256": sha256.New,
+	"HS384": sha512.New384,
+	"HS512": sha512.New,
+}
+
+// Arguments adds per-command args to the object.
+func (j *jwtCommand) Arguments(f *flag.FlagSet) {
+	f.BoolVar(&j.verify, "verify", false, "Verify the signature, and the exp/nbf claims, of the token")
+	f.StringVar(&j.secret, "secret", "", "The secret to verify the signature with")
+}
+
+// Info returns the name of this subcommand.
+func (j *jwtCommand) Info() (string, string) {
+	return "jwt", `Decode, and optionally verify, JSON Web Tokens.
+
+Details:
+
+This command decodes the given JSON Web Token, or one read from STDIN,
+and pretty-prints its header and payload.  The signature is not checked
+by default, so that any token may be inspected.
+
+With '-verify' the HMAC signature is checked against the secret given
+via '-secret', and the 'exp' and 'nbf' claims are checked against the
+current time.  If the token isn't valid a non-zero exit-code is returned.
+
+Only the HMAC algorithms, HS256, HS384, and HS512, may be verified.
+
+Examples:
+
+$ sysbox jwt eyJhbGciOiJIUzI1NiJ9.eyJzdWIiOiJzdGV2ZSJ9.pgk4q...
+$ echo $TOKEN | sysbox jwt -verify -secret s3cr3t`
+}
+
+// segment decodes, and pretty-prints, a JSON segment of the token.
+func (j *jwtCommand) segment(name string, data string) ([]byte, error) {
+
+	raw, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(data, "="))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %s", name, err.Error())
+	}
+
+	var pretty bytes.Buffer
+	if err := json.Indent(&pretty, raw, "", "  "); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %s", name, err.Error())
+	}
+	return pretty.Bytes(), nil
+}
+
+// claimTime returns the time held in the named claim, if it is present.
+func claimTime(claims map[string]interface{}, name string) (time.Time, bool, error) {
+
+	value, ok := claims[name]
+	if !ok {
+		return time.Time{}, false, nil
+	}
+
+	number, ok := value.(json.Number)
+	if !ok {
+		return time.Time{}, false, fmt.Errorf("the %s claim isn't a number", name)
+	}
+	seconds, err := number.Float64()
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return time.Unix(int64(seconds), 0), true, nil
+}
+
+// check verifies the token, showing the results, and returns true if it
+// is valid.
+func (j *jwtCommand) check(parts []string, header []byte, payload []byte) (bool, error) {
+
+	var head struct {
+		Alg string `json:"alg"`
+	}
+	if err := json.Unmarshal(header, &head); err != nil {
+		return false, err
+	}
+
+	algorithm, ok := jwtAlgorithms[head.Alg]
+	if !ok {
+		return false, fmt.Errorf("unsupported algorithm '%s'", head.Alg)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[2], "="))
+	if err != nil {
+		return false, fmt.Errorf("failed to decode signature: %s", err.Error())
+	}
+
+	valid := true
+
+	mac := hmac.New(algorithm, []byte(j.secret))
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if hmac.Equal(mac.Sum(nil), signature) {
+		fmt.Printf("Signature:  OK (%s)\n", head.Alg)
+	} else {
+		fmt.Printf("Signature:  INVALID (%s)\n", head.Alg)
+		valid = false
+	}
+
+	// Check the validity period.
+	dec := json.NewDecoder(bytes.NewReader(payload))
+	dec.UseNumber()
+
+	var claims map[string]interface{}
+	if err := dec.Decode(&claims); err != nil {
+		return false, err
+	}
+
+	now := time.Now()
+
+	exp, ok, err := claimTime(claims, "exp")
+	if err != nil {
+		return false, err
+	}
+	if ok {
+		if now.Before(exp) {
+			fmt.Printf("Expires:    %s (OK)\n", exp.Format(time.RFC3339))
+		} else {
+			fmt.Printf("Expires:    %s (EXPIRED)\n", exp.Format(time.RFC3339))
+			valid = false
+		}
+	}
+
+	nbf, ok, err := claimTime(claims, "nbf")
+	if err != nil {
+		return false, err
+	}
+	if ok {
+		if now.Before(nbf) {
+			fmt.Printf("Not Before: %s (NOT YET VALID)\n", nbf.Format(time.RFC3339))
+			valid = false
+		} else {
+			fmt.Printf("Not Before: %s (OK)\n", nbf.Format(time.RFC3339))
+		}
+	}
+
+	return valid, nil
+}
+
+// Execute is invoked if the user specifies `jwt` as the subcommand.
+func (j *jwtCommand) Execute(args []string) int {
+
+	if len(args) > 1 {
+		fmt.Printf("Usage: jwt [-verify -secret SECRET] [token]\n")
+		return 1
+	}
+	if j.verify && j.secret == "" {
+		fmt.Printf("error: -verify requires a -secret\n")
+		return 1
+	}
+
+	// Read the token from STDIN, if it wasn't given.
+	token := ""
+	if len(args) == 1 {
+		token = args[0]
+	} else {
+		data, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			return 1
+		}
+		token = string(data)
+	}
+
+	token = strings.TrimSpace(token)
+	token = strings.TrimPrefix(token, "Bearer ")
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		fmt.Printf("error: a token must have three parts, separated by '.'\n")
+		return 1
+	}
+
+	header, err := j.segment("header", parts[0])
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+	payload, err := j.segment("payload", parts[1])
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+
+	fmt.Printf("Header:\n%s\n\nPayload:\n%s\n", header, payload)
+
+	if !j.verify {
+		return 0
+	}
+
+	fmt.Println()
+	valid, err := j.check(parts, header, payload)
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+	if !valid {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Structure for our options and state.
+type morseCommand struct {
+
+	// Decode morse code to text?
+	decode bool
+
+	// Play the code via the terminal bell?
+	sound bool
+
+	// Did we skip anything?
+	skipped bool
+}
+
+// morseCodes maps characters to their morse code.
+var morseCodes = map[rune]string{
+	'A': ".-", 'B': "-...", 'C': "-.-.", 'D': "-..", 'E': ".", 'F': "..-.",
+	'G': "--.", 'H': "....", 'I': "..", 'J': ".---", 'K': "-.-", 'L': ".-..",
+	'M': "--", 'N': "-.", 'O': "---", 'P': ".--.", 'Q': "--.-", 'R': ".-.",
+	'S': "...", 'T': "-", 'U': "..-", 'V': "...-", 'W': ".--", 'X': "-..-",
+	'Y': "-.--", 'Z': "--..",
+	'0': "-----", '1': ".----", '2': "..---", '3': "...--", '4': "....-",
+	'5': ".....", '6': "-....", '7': "--...", '8': "---..", '9': "----.",
+	'.': ".-.-.-", ',': "--..--", '?': "..--..", '\'': ".----.", '!': "-.-.--",
+	'/': "-..-.", '(': "-.--.", ')': "-.--.-", '&': ".-...", ':': "---...",
+	';': "-.-.-.", '=': "-...-", '+': ".-.-.", '-': "-....-", '_': "..--.-",
+	'"': ".-..-.", '$': "...-..-", '@': ".--.-.",
+}
+
+// morseUnit is the length of a dot, when playing the code.
+const morseUnit = 120 * time.Millisecond
+
+// Arguments adds per-command args to the object.
+func (m *morseCommand) Arguments(f *flag.FlagSet) {
+	f.BoolVar(&m.decode, "d", false, "Decode morse code to text")
+	f.BoolVar(&m.sound, "sound", false, "Play the code via the terminal bell")
+}
+
+// Info returns the name of this subcommand.
+func (m *morseCommand) Info() (string, string) {
+	return "morse", `Convert text to, and from, morse code.
+
+Details:
+
+This command converts the given text to morse code, or with '-d' converts
+morse code back to text.  The input is read from STDIN if no arguments
+are given.
+
+Letters, digits, and common punctuation are supported.  In morse code
+each character is separated by a space, and each word by '/'.  Anything
+which can't be converted is skipped, with a warning.
+
+The '-sound' flag plays the code via the terminal bell, as it is shown.
+As the bell has a fixed length dots and dashes are told apart by the
+pause which follows them.
+
+Examples:
+
+$ sysbox morse SOS
+... --- ...
+$ sysbox morse -d '.... . .-.. .-.. --- / .-- --- .-. .-.. -..'
+HELLO WORLD`
+}
+
+// warn reports something we couldn't convert.
+func (m *morseCommand) warn(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "warning: "+format+"\n", args...)
+	m.skipped = true
+}
+
+// toMorse converts text to morse code.
+func (m *morseCommand) toMorse(text string) string {
+
+	var words []string
+	for _, word := range strings.Fields(text) {
+		var letters []string
+		for _, c := range word {
+			code, ok := morseCodes[unicode.ToUpper(c)]
+			if !ok {
+				m.warn("skipping unknown character '%c'", c)
+				continue
+			}
+			letters = append(letters, code)
+		}
+		if len(letters) > 0 {
+			words = append(words, strings.Join(letters, " "))
+		}
+	}
+	return strings.Join(words, " / ")
+}
+
+// fromMorse converts morse code to text.
+func (m *morseCommand) fromMorse(code string) string {
+
+	letters := make(map[string]rune)
+	for c, code := range morseCodes {
+		letters[code] = c
+	}
+
+	var words []string
+	for _, word := range strings.Split(code, "/") {
+		var text strings.Builder
+		for _, letter := range strings.Fields(word) {
+			c, ok := letters[letter]
+			if !ok {
+				m.warn("skipping unknown code '%s'", letter)
+				continue
+			}
+			text.WriteRune(c)
+		}
+		if text.Len() > 0 {
+			words = append(words, text.String())
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// play shows the morse code, sounding the bell for each dot and dash.
+func (m *morseCommand) play(code string) {
+
+	for _, c := range code {
+		fmt.Printf("%c", c)
+		switch c {
+		case '.':
+			fmt.Print("\a")
+			time.Sleep(morseUnit * 2)
+		case '-':
+			fmt.Print("\a")
+			time.Sleep(morseUnit * 4)
+		case ' ':
+			time.Sleep(morseUnit * 2)
+		case '/':
+			time.Sleep(morseUnit * 3)
+		}
+	}
+	fmt.Println()
+}
+
+// Execute is invoked if the user specifies `morse` as the subcommand.
+func (m *morseCommand) Execute(args []string) int {
+
+	input := strings.Join(args, " ")
+	if len(args) == 0 {
+		data, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			fmt.Printf("error reading STDIN: %s\n", err.Error())
+			return 1
+		}
+		input = string(data)
+	}
+
+	if m.decode {
+		fmt.Println(m.fromMorse(input))
+		if m.sound {
+			m.play(strings.Join(strings.Fields(input), " "))
+		}
+	} else {
+		code := m.toMorse(input)
+		if m.sound {
+			m.play(code)
+		} else {
+			fmt.Println(code)
+		}
+	}
+
+	if m.skipped {
+		return 1
+	}
+	return 0
+}
@@ -1,10 +1,13 @@
 package main
 
 import (
+	"crypto/rand"
 	"flag"
 	"fmt"
-	"math/rand"
-	"time"
+	"math"
+	"math/big"
+	"os"
+	"strings"
 )
 
 // Structure for our options and state.
@@ -18,6 +21,18 @@ type passwordCommand struct {
 
 	// Digits?
 	digits bool
+
+	// Upper-case letters?
+	uppercase bool
+
+	// The number of passwords to generate
+	count int
+
+	// Generate pronounceable passwords?
+	pronounceable bool
+
+	// Generate a passphrase of this many words, instead of a password
+	words int
 }
 
 // Arguments adds per-command args to the object.
@@ -25,6 +40,10 @@ func (p *passwordCommand) Arguments(f *flag.FlagSet) {
 	f.IntVar(&p.length, "length", 15, "The length of the password to generate")
 	f.BoolVar(&p.specials, "specials", true, "Should we use special characters?")
 	f.BoolVar(&p.digits, "digits", true, "Should we use digits?")
+	f.BoolVar(&p.uppercase, "uppercase", true, "Should we use upper-case letters?")
+	f.IntVar(&p.count, "count", 1, "The number of passwords to generate")
+	f.BoolVar(&p.pronounceable, "pronounceable", false, "Generate passwords which are easier to read aloud")
+	f.IntVar(&p.words, "words", 0, "Generate a passphrase of this many words, instead of a password")
 }
 
 // Info returns the name of this subcommand.
@@ -33,33 +52,75 @@ func (p *passwordCommand) Info() (string, string) {
 
 Details:
 
-This command generates a simple random password, by default being 12
+This command generates a simple random password, by default being 15
 characters long.  You can tweak the alphabet used via the command-line
-flags if necessary.`
+flags if necessary, for example '-specials=false' will avoid the use of
+special characters, and '-uppercase=false' will avoid capital letters.
+
+Several passwords may be generated at once via '-count'.
+
+Passwords which are easier to read aloud, made from alternating
+consonants and vowels, may be generated via '-pronounceable'.
+
+Alternatively '-words N' will generate a diceware-style passphrase of N
+words, chosen from a built-in list of 7776.  Each word adds about 12.9
+bits of entropy, which is reported on STDERR, so six words or more are
+recommended.
+
+This command may also be invoked as 'genpasswd'.
+
+All randomness comes from the operating system's secure random number
+generator.
+
+Examples:
+
+$ sysbox make-password -length 20 -specials=false
+$ sysbox make-password -words 6`
 }
 
-// Execute is invoked if the user specifies `make-password` as the subcommand.
-func (p *passwordCommand) Execute(args []string) int {
-	rand.Seed(time.Now().UnixNano())
+// randomInt returns a uniformly distributed random number in [0,n).
+func randomInt(n int) int {
+	v, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		panic(fmt.Sprintf("failed to read random data: %s", err))
+	}
+	return int(v.Int64())
+}
 
-	// Alphabets we use for generation
-	digits := "0123456789"
-	specials := "~=&+%^*/()[]{}/!@#$?|"
-	all := "ABCDEFGHIJKLMNOPQRSTUVWXYZ" +
-		"abcdefghijklmnopqrstuvwxyz"
+// randomChar returns a random character from the given alphabet.
+func randomChar(alphabet string) byte {
+	return alphabet[randomInt(len(alphabet))]
+}
+
+// Alphabets we use for generation
+const (
+	passwordLowercase  = "abcdefghijklmnopqrstuvwxyz"
+	passwordUppercase  = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	passwordDigits     = "0123456789"
+	passwordSpecials   = "~=&+%^*/()[]{}/!@#$?|"
+	passwordVowels     = "aeiou"
+	passwordConsonants = "bcdfghjklmnprstvwz"
+)
+
+// password generates a random password.
+func (p *passwordCommand) password() string {
 
 	// Extend our alphabet if we should
+	all := passwordLowercase
+	if p.uppercase {
+		all = all + passwordUppercase
+	}
 	if p.digits {
-		all = all + digits
+		all = all + passwordDigits
 	}
 	if p.specials {
-		all = all + specials
+		all = all + passwordSpecials
 	}
 
 	// Make a buffer and fill it with all characters
 	buf := make([]byte, p.length)
 	for i := 0; i < p.length; i++ {
-		buf[i] = all[rand.Intn(len(all))]
+		buf[i] = randomChar(all)
 	}
 
 	// Add a digit if we should.
@@ -67,8 +128,8 @@ func (p *passwordCommand) Execute(args []string) int {
 	// We might already have them present, because our `all`
 	// alphabet was used already.  But this ensures we have at
 	// least one digit present.
-	if p.digits {
-		buf[0] = digits[rand.Intn(len(digits))]
+	if p.digits && p.length > 0 {
+		buf[0] = randomChar(passwordDigits)
 	}
 
 	// Add a special-character if we should.
@@ -76,15 +137,106 @@ func (p *passwordCommand) Execute(args []string) int {
 	// We might already have them present, because our `all`
 	// alphabet was used already.  But this ensures we have at
 	// least one special-character present.
-	if p.specials {
-		buf[1] = specials[rand.Intn(len(specials))]
+	if p.specials && p.length > 1 {
+		buf[1] = randomChar(passwordSpecials)
 	}
 
-	// Shuffle and output
-	rand.Shuffle(len(buf), func(i, j int) {
+	// Shuffle, via Fisher-Yates
+	for i := len(buf) - 1; i > 0; i-- {
+		j := randomInt(i + 1)
 		buf[i], buf[j] = buf[j], buf[i]
-	})
-	fmt.Printf("%s\n", buf)
+	}
+
+	return string(buf)
+}
+
+// pronounceablePassword generates a password from alternating consonants
+// and vowels, followed by a digit and a special-character if we should
+// use them.
+func (p *passwordCommand) pronounceablePassword() string {
+
+	suffix := ""
+	if p.digits {
+		suffix += string(randomChar(passwordDigits))
+	}
+	if p.specials {
+		suffix += string(randomChar(passwordSpecials))
+	}
+
+	buf := make([]byte, 0, p.length)
+	for i := 0; len(buf)+len(suffix) < p.length; i++ {
+		if i%2 == 0 {
+			buf = append(buf, randomChar(passwordConsonants))
+		} else {
+			buf = append(buf, randomChar(passwordVowels))
+		}
+	}
+
+	// Capitalize the first letter, if we should.
+	if p.uppercase && len(buf) > 0 {
+		buf[0] = buf[0] - 'a' + 'A'
+	}
+
+	return string(buf) + suffix
+}
+
+// entropy returns the number of bits of entropy in a passphrase of the
+// given number of words.
+func entropy(words int) float64 {
+	return float64(words) * math.Log2(float64(len(passwordWords)))
+}
+
+// passphrase generates a passphrase from our list of words.
+func (p *passwordCommand) passphrase() string {
+
+	words := make([]string, p.words)
+	for i := range words {
+		words[i] = passwordWords[randomInt(len(passwordWords))]
+	}
+	return strings.Join(words, "-")
+}
+
+// Execute is invoked if the user specifies `make-password` as the subcommand.
+func (p *passwordCommand) Execute(args []string) int {
+
+	if p.length < 1 || p.count < 1 {
+		fmt.Printf("error: -length and -count must be positive\n")
+		return 1
+	}
+	if p.words < 0 {
+		fmt.Printf("error: -words must not be negative\n")
+		return 1
+	}
+
+	if p.words > 0 {
+		fmt.Fprintf(os.Stderr, "entropy: %.1f bits (%d words, %.1f bits each)\n",
+			entropy(p.words), p.words, entropy(1))
+	}
+
+	for i := 0; i < p.count; i++ {
+		switch {
+		case p.words > 0:
+			fmt.Printf("%s\n", p.passphrase())
+		case p.pronounceable:
+			fmt.Printf("%s\n", p.pronounceablePassword())
+		default:
+			fmt.Printf("%s\n", p.password())
+		}
+	}
 
 	return 0
 }
+
+// genpasswdCommand allows make-password to be invoked as genpasswd.
+//
+// We avoid the name passwd, as `sysbox install` would then shadow the
+// system command of the same name.
+type genpasswdCommand struct {
+	passwordCommand
+}
+
+// Info returns the name of this subcommand.
+func (g *genpasswdCommand) Info() (string, string) {
+	_, help := g.passwordCommand.Info()
+	return "genpasswd", help
+}
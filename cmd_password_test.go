@@ -0,0 +1,28 @@
+package main
+
+import (
+	"regexp"
+	"testing"
+)
+
+// TestPasswordWords ensures our word-list is the size of a diceware
+// list, and contains only unique lower-case words.
+func TestPasswordWords(t *testing.T) {
+
+	if len(passwordWords) != 7776 {
+		t.Fatalf("expected 7776 words, got %d", len(passwordWords))
+	}
+
+	valid := regexp.MustCompile(`^[a-z]{3,9}$`)
+	seen := make(map[string]bool)
+
+	for _, word := range passwordWords {
+		if !valid.MatchString(word) {
+			t.Fatalf("invalid word '%s'", word)
+		}
+		if seen[word] {
+			t.Fatalf("duplicate word '%s'", word)
+		}
+		seen[word] = true
+	}
+}
@@ -0,0 +1,1158 @@
+package main
+
+// passwordWords is the list of words used to generate passphrases.
+//
+// There are 7776 of them, the same number as a diceware list, so each
+// word chosen adds a little under 13 bits of entropy to a passphrase.
+var passwordWords = []string{
+	"aback", "abacus", "abalone", "abandon", "abate", "abbey", "abbot",
+	"abdicate", "abdomen", "abduct", "aberrant", "abet", "abhor", "abide",
+	"abiding", "ability", "abject", "ablaze", "able", "aboard", "abode",
+	"abolish", "abound", "about", "above", "abrasion", "abrasive", "abridge",
+	"abroad", "abrupt", "abscond", "abseil", "absence", "absent", "absentee",
+	"absolute", "absolved", "absorb", "abstain", "abstinent", "abstract",
+	"absurd", "abundance", "abundant", "abyss", "academic", "academy",
+	"accede", "accent", "accept", "accepted", "access", "accessory",
+	"accident", "acclaim", "acclimate", "accolade", "accompany", "accord",
+	"accordion", "account", "accredit", "accrue", "accuracy", "accurate",
+	"accuse", "accused", "ace", "acetone", "ache", "achieve", "achieved",
+	"achiever", "acid", "acidic", "acne", "acolyte", "acorn", "acoustic",
+	"acquaint", "acquire", "acquired", "acquit", "acre", "acreage", "acrid",
+	"acrobat", "acronym", "across", "acrylic", "act", "acting", "action",
+	"activate", "active", "activist", "activity", "actor", "actress",
+	"actual", "actuary", "acuity", "acumen", "acute", "adage", "adamant",
+	"adapt", "adaptable", "adapted", "adapter", "add", "added", "addendum",
+	"addict", "adding", "addition", "additive", "address", "adept",
+	"adequacy", "adequate", "adhere", "adhesion", "adhesive", "adjacent",
+	"adjective", "adjoin", "adjourn", "adjunct", "adjust", "admiral",
+	"admire", "admission", "admit", "admitted", "admonish", "adobe", "adopt",
+	"adopted", "adopter", "adoption", "adorable", "adore", "adored", "adorn",
+	"adrift", "adroit", "adult", "advance", "advantage", "advent",
+	"adventure", "adverb", "adversary", "advert", "advice", "advise",
+	"advised", "advisor", "advocate", "aerial", "aerobic", "aerosol",
+	"aesthetic", "afar", "affable", "affair", "affect", "affection",
+	"affinity", "affirm", "affirmed", "afflict", "affluent", "afford",
+	"afforest", "afghan", "afield", "aflame", "afloat", "afoot", "afraid",
+	"afresh", "after", "aftermath", "afternoon", "afterward", "again",
+	"agate", "ageless", "agency", "agenda", "agent", "aggregate", "aghast",
+	"agile", "aging", "agitate", "agitated", "aglow", "agony", "agree",
+	"agreeable", "agreed", "agreement", "agronomy", "aground", "ahead", "aid",
+	"aide", "aided", "aileron", "ailing", "ailment", "aim", "aiming",
+	"aimless", "air", "airbag", "airborne", "airbrush", "airbus", "airfare",
+	"airfield", "airflow", "airhead", "airless", "airlift", "airline",
+	"airlock", "airmail", "airplane", "airport", "airship", "airspace",
+	"airstrip", "airtight", "airwave", "airway", "airy", "aisle", "ajar",
+	"akin", "alacrity", "alarm", "alarmed", "albatross", "albino", "album",
+	"alchemy", "alcove", "alder", "alert", "alertness", "alfalfa", "algae",
+	"algebra", "alias", "alibi", "alien", "align", "aligned", "alignment",
+	"alike", "alimony", "alive", "alkaline", "allergic", "allergy", "alley",
+	"alleyway", "alliance", "alligator", "allocate", "allot", "allotment",
+	"allow", "allowance", "allowed", "alloy", "allspice", "allude", "allure",
+	"alluvial", "ally", "almanac", "almighty", "almond", "almost", "alms",
+	"aloe", "aloft", "aloha", "alone", "along", "alongside", "aloof", "aloud",
+	"alpaca", "alphabet", "alpine", "already", "also", "altar", "alter",
+	"altered", "although", "altimeter", "altitude", "alto", "aluminum",
+	"alumni", "always", "amateur", "amaze", "amazed", "amazement", "amazing",
+	"amber", "ambience", "ambiguous", "ambition", "ambitious", "amble",
+	"ambulance", "ambush", "amenable", "amend", "amenity", "amethyst",
+	"amiable", "amicable", "amid", "amiss", "ammonia", "amnesty", "amoeba",
+	"amorous", "amount", "amphibian", "ample", "amplifier", "amplify",
+	"amulet", "amuse", "amused", "amusement", "amusing", "anagram", "analog",
+	"analogy", "analysis", "analyst", "analyze", "anarchy", "anatomy",
+	"ancestor", "ancestry", "anchor", "anchovy", "ancient", "android",
+	"anecdote", "anemone", "anew", "angel", "angelic", "anger", "angle",
+	"angled", "angler", "angora", "angry", "anguish", "angular", "animal",
+	"animate", "animated", "animation", "anise", "ankle", "anklet", "annals",
+	"anneal", "annex", "annexed", "annotate", "announce", "announced",
+	"annoy", "annoyed", "annual", "anoint", "anomaly", "anonymous", "anorak",
+	"another", "answer", "answered", "ant", "antacid", "antarctic",
+	"anteater", "antelope", "antenna", "anthem", "anthill", "anthology",
+	"antibody", "antidote", "antimony", "antique", "antiquity", "antler",
+	"antonym", "anvil", "anxiety", "anxious", "anybody", "anyhow", "anyone",
+	"anyplace", "anything", "anytime", "anytown", "anyway", "anywhere",
+	"apart", "apartment", "apathy", "aperture", "apex", "aphid", "aphorism",
+	"apiary", "apiece", "aplenty", "aplomb", "apology", "apostle", "apparel",
+	"appeal", "appear", "appeared", "appease", "append", "appendix",
+	"appetite", "applaud", "applause", "apple", "applet", "appliance",
+	"applicant", "applied", "apply", "appoint", "apposite", "appraisal",
+	"appraise", "apprehend", "approach", "approval", "approve", "approved",
+	"apricot", "apron", "aptitude", "aptly", "aquarium", "aquatic",
+	"aqueduct", "arable", "arbiter", "arbitrary", "arbor", "arboretum",
+	"arcade", "arch", "archaic", "arched", "archer", "archery", "architect",
+	"archive", "archway", "arctic", "ardent", "ardor", "arduous", "area",
+	"arena", "argon", "argue", "argued", "argyle", "aria", "arid", "arise",
+	"armada", "armadillo", "armchair", "armful", "armistice", "armoire",
+	"armor", "armored", "armory", "armpit", "armrest", "army", "aroma",
+	"around", "arouse", "arpeggio", "arrange", "arranged", "array", "arrest",
+	"arrested", "arrival", "arrive", "arrived", "arrogant", "arrow",
+	"arrowhead", "art", "artery", "artful", "artichoke", "article",
+	"artifact", "artisan", "artist", "artistic", "artistry", "artwork",
+	"asbestos", "ascend", "ascent", "ascetic", "ascot", "ashen", "ashore",
+	"ashtray", "aside", "ask", "asked", "asking", "asleep", "asparagus",
+	"aspect", "aspen", "asphalt", "aspire", "aspirin", "assemble", "assembly",
+	"assert", "assertive", "assess", "asset", "assign", "assist", "assorted",
+	"assume", "assure", "assured", "asterisk", "asteroid", "asthma",
+	"astonish", "astound", "astronaut", "astronomy", "astute", "asylum",
+	"atelier", "athlete", "atlas", "atoll", "atom", "atomic", "atrium",
+	"attach", "attached", "attack", "attain", "attempt", "attend",
+	"attendant", "attended", "attention", "attentive", "attest", "attic",
+	"attire", "attitude", "attorney", "attract", "attribute", "auburn",
+	"auction", "audacious", "audible", "audience", "audio", "audit",
+	"audition", "auditor", "auger", "augment", "augur", "august", "auklet",
+	"aunt", "aura", "aurora", "auspice", "austere", "authentic", "author",
+	"authority", "autograph", "autopilot", "autumn", "auxiliary", "avail",
+	"avalanche", "avatar", "avenge", "avenue", "average", "aversion", "avert",
+	"avian", "aviary", "aviation", "aviator", "avid", "avionics", "avocado",
+	"avoid", "avoided", "avow", "await", "awaited", "awake", "awaken",
+	"awakened", "award", "awarded", "aware", "away", "awe", "awesome",
+	"awful", "awhile", "awkward", "awning", "axiom", "axis", "axle",
+	"axolotl", "azalea", "azure", "babble", "baboon", "baby", "babysit",
+	"bachelor", "back", "backache", "backbeat", "backbone", "backcourt",
+	"backdate", "backdoor", "backdrop", "backer", "backfire", "backhand",
+	"backing", "backlash", "backlit", "backlog", "backorder", "backpack",
+	"backrest", "backroom", "backseat", "backside", "backslash", "backspin",
+	"backstage", "backtrack", "backup", "backward", "backwater", "backwoods",
+	"backyard", "bacon", "badge", "badger", "badly", "badminton", "baffle",
+	"bagel", "bagful", "baggage", "baggy", "bagpipe", "baguette", "bail",
+	"bailiff", "bait", "bake", "baked", "baker", "bakery", "bakeware",
+	"balaclava", "balance", "balanced", "balcony", "bald", "bale", "ball",
+	"ballad", "ballast", "ballerina", "ballet", "ballgame", "ballistic",
+	"balloon", "ballot", "ballpark", "ballpoint", "ballroom", "balmy",
+	"balsa", "balsam", "baluster", "bamboo", "banana", "band", "bandage",
+	"bandana", "banded", "bandit", "bandstand", "bandwagon", "bandwidth",
+	"bang", "banish", "banister", "banjo", "bank", "bankbook", "banked",
+	"banker", "bankroll", "banner", "banquet", "banter", "banyan", "baptism",
+	"barbecue", "barbell", "barber", "barcode", "bard", "bare", "bareback",
+	"barefoot", "barely", "bargain", "barge", "barista", "baritone", "bark",
+	"barked", "barley", "barn", "barnacle", "barnyard", "barometer", "baron",
+	"barrack", "barracks", "barracuda", "barrel", "barren", "barrette",
+	"barricade", "barrier", "barrow", "bartender", "barter", "basalt", "base",
+	"baseball", "baseboard", "baseline", "basement", "bashful", "basic",
+	"basics", "basil", "basin", "basis", "basked", "basket", "bass",
+	"bassinet", "bassist", "bassoon", "baste", "bastion", "batch", "bath",
+	"bathed", "bathhouse", "bathmat", "bathrobe", "bathroom", "bathtub",
+	"batik", "baton", "batsman", "battalion", "batted", "batter", "battering",
+	"battery", "batting", "battle", "bauble", "bay", "bayonet", "bayou",
+	"bazaar", "beach", "beachball", "beacon", "bead", "beaded", "beadwork",
+	"beagle", "beak", "beaker", "beam", "beamed", "bean", "beanbag", "beanie",
+	"beanpole", "bear", "beard", "bearded", "bearing", "bearskin", "beast",
+	"beastly", "beat", "beatnik", "beautiful", "beauty", "beaver", "beckon",
+	"become", "bedazzle", "bedbug", "bedded", "bedding", "bedpost", "bedrock",
+	"bedroom", "bedside", "bedspread", "bedstead", "bedtime", "beech", "beef",
+	"beefsteak", "beehive", "beekeeper", "beeline", "beep", "beeswax",
+	"beetle", "befall", "before", "befriend", "beget", "beggar", "begged",
+	"begin", "beginner", "begonia", "beguile", "begun", "behalf", "behave",
+	"behaved", "behind", "behold", "beige", "being", "belated", "belay",
+	"belfry", "belief", "believe", "belittle", "bell", "bellboy", "bellhop",
+	"bellow", "belly", "belong", "belonging", "beloved", "below", "belt",
+	"belted", "beltway", "beluga", "bemused", "bench", "benchmark", "bend",
+	"bended", "beneath", "benefit", "benign", "bent", "bento", "bequeath",
+	"beret", "bergamot", "berry", "berserk", "berth", "beseech", "beside",
+	"besiege", "best", "bestow", "betray", "betrothal", "better", "between",
+	"beverage", "beware", "bewilder", "bewitch", "beyond", "biannual",
+	"biathlon", "bicker", "bicycle", "bidder", "biennial", "bifocal",
+	"bifurcate", "big", "bighorn", "bigwig", "bike", "bilingual", "bill",
+	"billboard", "billfold", "billiards", "billion", "billow", "bimonthly",
+	"bin", "bind", "binder", "binding", "bingo", "binocular", "biofuel",
+	"biography", "biology", "biome", "biopsy", "biplane", "birch",
+	"birchbark", "bird", "birdbath", "birdcage", "birdhouse", "birdsong",
+	"birth", "birthday", "birthmark", "biryani", "biscuit", "bisect",
+	"bishop", "bison", "bistro", "bite", "bitten", "bitter", "bittern",
+	"bivouac", "blab", "black", "blackbird", "blackout", "blacktop",
+	"bladder", "blade", "blame", "blamed", "blameless", "bland", "blandly",
+	"blank", "blanket", "blare", "blaring", "blast", "blastoff", "blaze",
+	"blazer", "bleach", "bleak", "bleary", "bleat", "blemish", "blend",
+	"blended", "blender", "bless", "blessed", "blessing", "blight", "blimp",
+	"blind", "blindfold", "blink", "blinked", "blinker", "bliss", "blissful",
+	"blister", "blithe", "blitz", "blizzard", "bloat", "block", "blockade",
+	"blocked", "blogger", "blond", "blood", "bloom", "bloomed", "blooper",
+	"blossom", "blot", "blotted", "blotter", "blouse", "blow", "blowfish",
+	"blowtorch", "blubber", "blue", "bluebell", "blueberry", "bluebird",
+	"bluegrass", "bluejay", "blueprint", "bluesy", "bluff", "blunder",
+	"blunt", "blur", "blurb", "blurred", "blush", "blushed", "bluster",
+	"boar", "board", "boarded", "boardwalk", "boast", "boasted", "boat",
+	"boatload", "boatyard", "bobbin", "bobcat", "bobsled", "bobtail",
+	"bodice", "bodily", "body", "bodyguard", "bog", "bogus", "boil", "boiled",
+	"boiler", "bold", "bolero", "bologna", "bolster", "bolt", "bolted",
+	"bonanza", "bond", "bone", "bonfire", "bonnet", "bonsai", "bonus", "book",
+	"bookcase", "bookend", "bookish", "booklet", "bookmark", "bookshelf",
+	"bookstore", "bookworm", "boom", "boomerang", "boorish", "boost",
+	"boosted", "boot", "booth", "bootlace", "bootleg", "border", "bordered",
+	"bored", "boring", "born", "borough", "borrow", "borrowed", "bosom",
+	"boss", "bosun", "botanist", "botany", "botch", "both", "bother",
+	"bottle", "bottled", "bottom", "bough", "boulder", "boulevard", "bounce",
+	"bounced", "bound", "bountiful", "bounty", "bouquet", "bout", "boutique",
+	"bovine", "bowl", "bowled", "bowler", "bowline", "bowsprit", "bowtie",
+	"box", "boxcar", "boxed", "boxer", "boxwood", "boycott", "boyhood",
+	"bracelet", "bracket", "brackish", "bract", "brag", "braid", "braided",
+	"braille", "brain", "brainy", "braise", "braised", "brake", "bramble",
+	"bran", "branch", "branched", "brand", "branded", "brandish", "brandy",
+	"brass", "brasserie", "bravado", "brave", "bravery", "bravo", "brawl",
+	"brawny", "brazen", "breach", "bread", "breaded", "breadth", "break",
+	"breaker", "breakfast", "bream", "breath", "breathe", "breech", "breeze",
+	"breezed", "breezy", "brethren", "brevity", "brew", "brewed", "brewery",
+	"briar", "brick", "bricked", "bridal", "bride", "bridge", "bridged",
+	"bridle", "brief", "briefcase", "briefed", "brigade", "brigand", "bright",
+	"brighten", "brilliant", "brim", "brimmed", "brindle", "brine", "bring",
+	"brink", "brioche", "briquette", "brisk", "bristle", "bristled",
+	"brittle", "broad", "broccoli", "brochure", "brogue", "broil", "broiled",
+	"broken", "broker", "bromide", "bronco", "bronze", "brooch", "brooding",
+	"brook", "broom", "broth", "brother", "brotherly", "brow", "brown",
+	"brownie", "browse", "bruise", "bruiser", "brunch", "brunette", "brush",
+	"brushed", "brusque", "brutal", "bubble", "bubbled", "buccaneer",
+	"bucket", "buckeye", "buckle", "buckled", "buckshot", "buckwheat",
+	"bucolic", "bud", "budded", "buddy", "budget", "budgie", "buffalo",
+	"buffed", "buffer", "buffet", "buffoon", "bug", "bugbear", "buggy",
+	"bugle", "bugled", "build", "builder", "built", "bulb", "bulge", "bulk",
+	"bulkhead", "bull", "bulldog", "bulldozer", "bulletin", "bullfinch",
+	"bullfrog", "bullhorn", "bullpen", "bully", "bulrush", "bumblebee",
+	"bump", "bumped", "bumper", "bumpy", "bunch", "bundle", "bundled",
+	"bungalow", "bungee", "bunk", "bunker", "bunny", "bunting", "buoy",
+	"burden", "bureau", "burger", "burgundy", "burial", "burlap", "burly",
+	"burn", "burned", "burner", "burnish", "burrito", "burrow", "burrowed",
+	"burst", "bury", "bus", "bused", "bush", "bushel", "business", "busker",
+	"bust", "bustle", "busy", "butcher", "butler", "butter", "buttercup",
+	"buttered", "butterfly", "button", "buttress", "buyer", "buzz", "buzzard",
+	"buzzed", "buzzer", "bygone", "bypass", "byte", "cabana", "cabaret",
+	"cabbage", "cabbie", "cabin", "cabinet", "cable", "cabled", "caboose",
+	"cackle", "cactus", "cadence", "cadenza", "cadet", "cadmium", "cafe",
+	"caffeine", "cage", "caged", "cairn", "cajole", "cake", "calamari",
+	"calamity", "calcium", "calculus", "caldron", "calendar", "calf",
+	"caliber", "calibrate", "calico", "call", "callus", "calm", "calmed",
+	"calorie", "calypso", "camel", "camellia", "cameo", "camera", "camisole",
+	"camomile", "camp", "campaign", "camped", "campfire", "campsite",
+	"campus", "camshaft", "canal", "canape", "canary", "cancel", "candid",
+	"candidate", "candle", "candlelit", "candor", "candy", "cane", "canine",
+	"canister", "canned", "cannery", "canning", "canoe", "canopy", "canteen",
+	"canter", "canto", "cantor", "canvas", "canyon", "capable", "capably",
+	"capacity", "cape", "caper", "capillary", "capital", "capped", "capstan",
+	"capsule", "captain", "caption", "captivate", "captive", "capture",
+	"carafe", "caramel", "carat", "caravan", "caraway", "carbide", "carbon",
+	"card", "cardamom", "cardboard", "carded", "cardigan", "cardinal", "care",
+	"cared", "career", "careful", "careless", "caress", "caretaker", "cargo",
+	"caribou", "carillon", "carload", "carnation", "carnival", "carnivore",
+	"carob", "carol", "carousel", "carpenter", "carpet", "carpeted",
+	"carpool", "carport", "carriage", "carried", "carrot", "carry",
+	"carryall", "cart", "cartel", "cartilage", "carton", "cartoon",
+	"cartridge", "cartwheel", "carve", "carved", "cascade", "case", "cash",
+	"cashed", "cashew", "cashier", "cashmere", "cask", "casket", "casserole",
+	"cassette", "cast", "castanet", "castaway", "casted", "castle", "casual",
+	"catalog", "catch", "category", "cater", "catered", "caterer", "catfish",
+	"cathedral", "catnap", "cattle", "catwalk", "caucus", "caught",
+	"cauldron", "cause", "caused", "causeway", "caution", "cavalier",
+	"cavalry", "cave", "cavern", "caviar", "cavity", "cease", "ceased",
+	"cedar", "cedilla", "ceiling", "celebrate", "celebrity", "celery",
+	"celeste", "celestial", "cell", "cellar", "cello", "cellphone",
+	"cellular", "cement", "census", "centaur", "center", "centered",
+	"centipede", "century", "ceramic", "ceramics", "cereal", "ceremony",
+	"certain", "certified", "cerulean", "chaffinch", "chagrin", "chain",
+	"chained", "chair", "chairlift", "chairman", "chaise", "chalet", "chalk",
+	"chalked", "chamber", "chameleon", "chamomile", "champ", "champagne",
+	"champion", "chance", "chancel", "change", "changed", "channel", "chant",
+	"chanted", "chaos", "chapel", "chaplain", "chapter", "charade",
+	"charcoal", "charge", "charged", "chariot", "charity", "charm", "charmed",
+	"charming", "chart", "charted", "charter", "chase", "chased", "chasm",
+	"chassis", "chastise", "chat", "chatted", "chatter", "chauffeur", "cheap",
+	"check", "checked", "checkers", "checkmate", "checkout", "cheek",
+	"cheekbone", "cheer", "cheered", "cheerful", "cheese", "cheetah", "chef",
+	"chemical", "chemist", "chemistry", "chenille", "cherish", "cherry",
+	"cherub", "chervil", "chess", "chest", "chestnut", "chew", "chewed",
+	"chick", "chicken", "chickpea", "chicory", "chief", "chieftain",
+	"chiffon", "chihuahua", "child", "chill", "chilled", "chilli", "chilly",
+	"chime", "chimed", "chimney", "chin", "china", "chinook", "chip",
+	"chipmunk", "chipped", "chirp", "chirped", "chisel", "chitchat",
+	"chivalry", "chive", "chlorine", "chocolate", "choice", "choir", "choke",
+	"choked", "chomped", "choose", "chop", "chopped", "chopstick", "chord",
+	"chore", "chorus", "chosen", "chowchow", "chowder", "chromatic", "chrome",
+	"chronic", "chronicle", "chrysalis", "chubby", "chuckle", "chugged",
+	"chunk", "chunky", "churn", "churned", "chutney", "cider", "cilantro",
+	"cinder", "cinema", "cinnamon", "circa", "circle", "circled", "circuit",
+	"circular", "circus", "citadel", "citation", "cite", "cited", "citizen",
+	"citizenry", "citrus", "city", "civic", "civil", "civility", "claim",
+	"claimed", "clam", "clambake", "clamor", "clamp", "clamped", "clan",
+	"clap", "clapboard", "clapped", "clarify", "clarinet", "clarity", "clash",
+	"clashed", "clasp", "clasped", "class", "classic", "classmate",
+	"classroom", "clatter", "clause", "claw", "clay", "clean", "cleaned",
+	"cleanser", "clear", "cleared", "clearing", "cleat", "cleaver",
+	"clemency", "clergy", "clergyman", "clerk", "clever", "cliche", "click",
+	"clicked", "client", "cliff", "climate", "climb", "climbed", "clinic",
+	"clinked", "clip", "clipboard", "clipped", "clipper", "cloak", "cloaked",
+	"cloakroom", "clock", "clocked", "clockwork", "clog", "cloister", "close",
+	"closed", "closet", "cloth", "clothed", "clothes", "cloud", "clouded",
+	"cloudless", "clover", "clown", "club", "clubbed", "clubhouse", "clue",
+	"clump", "clumped", "clumsy", "cluster", "clustered", "clutch", "coach",
+	"coached", "coal", "coalition", "coast", "coastal", "coasted", "coaster",
+	"coastline", "coat", "coated", "coattail", "coauthor", "coax", "coaxed",
+	"cobalt", "cobble", "cobbler", "cobra", "cobweb", "cockatoo", "cockle",
+	"cockpit", "cocoa", "coconut", "cocoon", "code", "coded", "codfish",
+	"coexist", "coffee", "coffer", "cog", "cognac", "cohesion", "coiffure",
+	"coil", "coiled", "coin", "coinage", "coined", "cold", "collage",
+	"collapse", "collar", "collect", "collected", "college", "collide",
+	"collie", "colonel", "colonial", "colonnade", "colony", "color",
+	"colored", "colorful", "coloring", "colossal", "colossus", "colt",
+	"column", "columnist", "comb", "combat", "combed", "combine", "combined",
+	"comeback", "comedy", "comet", "comfort", "comforted", "comfy", "comic",
+	"comma", "command", "commando", "commence", "commend", "comment",
+	"commerce", "commit", "commodore", "common", "commuted", "commuter",
+	"compact", "companion", "company", "compare", "compass", "compel",
+	"compete", "competent", "compile", "complain", "complete", "complex",
+	"comply", "compose", "composed", "composer", "compost", "compote",
+	"compound", "compute", "computed", "comrade", "concave", "conceal",
+	"concede", "conceded", "concept", "concert", "conch", "concierge",
+	"concise", "concluded", "concrete", "condor", "conduct", "conductor",
+	"cone", "confetti", "confide", "confirm", "confirmed", "conflict",
+	"confuse", "congress", "conifer", "conjure", "connect", "connected",
+	"conquer", "conscious", "consensus", "consent", "consider", "console",
+	"consoled", "constable", "constant", "consult", "consume", "contact",
+	"contain", "contained", "contend", "content", "contented", "contest",
+	"context", "continent", "continue", "contour", "contract", "control",
+	"convent", "convert", "convey", "convince", "convoy", "cook", "cookbook",
+	"cooked", "cookie", "cookware", "cool", "cooled", "coop", "copied",
+	"copilot", "copious", "copper", "copy", "copycat", "coral", "cord",
+	"corded", "cordial", "cordless", "corduroy", "core", "coriander", "cork",
+	"corked", "cormorant", "corn", "cornbread", "cornea", "corner", "cornet",
+	"cornfield", "cornice", "cornmeal", "corona", "corporal", "corral",
+	"correct", "corrected", "corridor", "corsage", "cosmic", "cosmos", "cost",
+	"costume", "costumed", "cottage", "cotton", "cottoned", "couch", "cougar",
+	"cough", "coughed", "council", "count", "counted", "counter", "countess",
+	"country", "county", "couple", "coupled", "coupon", "courage", "courier",
+	"course", "court", "courtship", "courtyard", "couscous", "cousin", "cove",
+	"covenant", "cover", "covered", "coverlet", "covet", "cowbell", "cowboy",
+	"cowered", "cowlick", "cowslip", "coxswain", "coyote", "cozy", "crab",
+	"crabapple", "crack", "cracked", "crackle", "cradle", "cradled", "craft",
+	"crafted", "craftsman", "crafty", "crag", "crammed", "cramp", "cramped",
+	"cranberry", "crane", "craned", "crank", "cranky", "crash", "crashed",
+	"crate", "crater", "crave", "craved", "crawl", "crawled", "crayfish",
+	"crayon", "craze", "creak", "cream", "creamed", "creamery", "creased",
+	"create", "created", "creative", "creature", "credible", "credit",
+	"credited", "creek", "creep", "crepe", "crescent", "crest", "crested",
+	"crevice", "crew", "crib", "cribbage", "cricket", "cried", "crimped",
+	"crimson", "cringe", "crinkle", "crinkled", "crisp", "crisped",
+	"criteria", "critic", "croak", "crochet", "crockery", "crocus",
+	"croissant", "crook", "crop", "croquet", "cross", "crossbow", "crossed",
+	"crossroad", "crossword", "crouch", "crouton", "crow", "crowbar", "crowd",
+	"crowded", "crown", "crowned", "crucial", "crucible", "crude", "cruel",
+	"cruise", "cruised", "cruiser", "crumb", "crumble", "crumbled", "crunch",
+	"crunched", "crusade", "crush", "crust", "crusted", "crutch", "cryptic",
+	"crystal", "cub", "cubbyhole", "cube", "cubed", "cubicle", "cuckoo",
+	"cucumber", "cuddle", "cuddled", "cudgel", "cue", "cued", "cuff",
+	"cuffed", "cuisine", "culinary", "culled", "culprit", "cultivate",
+	"culture", "cumin", "cunning", "cup", "cupboard", "cupcake", "cupola",
+	"cupped", "curable", "curator", "curb", "curbed", "curd", "curdle",
+	"cure", "cured", "curfew", "curio", "curiosity", "curious", "curl",
+	"curled", "curly", "currant", "currency", "current", "curry", "cursor",
+	"curtain", "curtained", "curtsy", "curve", "curved", "cushion",
+	"cushioned", "cushy", "custard", "custodian", "custom", "customer",
+	"cutback", "cutlery", "cutlet", "cyan", "cycle", "cycled", "cyclist",
+	"cyclone", "cylinder", "cymbal", "cypress", "dab", "dabbed", "dabble",
+	"dabbling", "dachshund", "daffodil", "daftly", "dahlia", "daily",
+	"dainty", "dairy", "daisy", "dale", "dallied", "dalmatian", "dam",
+	"damage", "damask", "damp", "dampened", "damsel", "damson", "dance",
+	"danced", "dancer", "dandelion", "dandruff", "danger", "dangle",
+	"dangled", "dapper", "dappled", "dare", "dared", "daring", "dark",
+	"darkened", "darkroom", "darling", "darn", "darned", "dart", "darted",
+	"dash", "dashboard", "dashed", "data", "database", "date", "dated",
+	"dateline", "daughter", "davenport", "dawdle", "dawn", "dawned", "daybed",
+	"daybreak", "daydream", "daylight", "daytime", "dazed", "dazzle",
+	"dazzled", "deacon", "deadbolt", "deadline", "deadlock", "deadpan",
+	"dealer", "dear", "dearth", "debate", "debated", "debonair", "debrief",
+	"debris", "debt", "debut", "decade", "decaf", "decanter", "decathlon",
+	"decay", "deceit", "deceive", "decent", "decibel", "decide", "decided",
+	"decimal", "decisive", "deck", "decked", "declare", "declared", "decline",
+	"declined", "declutter", "decode", "decoded", "decor", "decorate",
+	"decorum", "decoy", "decrease", "decree", "decreed", "decrypt",
+	"dedicate", "dedicated", "deduce", "deduced", "deed", "deemed", "deep",
+	"deepen", "deepwater", "deer", "default", "defeat", "defend", "defended",
+	"defer", "defiant", "deficit", "define", "defined", "deflate", "deflect",
+	"deflected", "deformed", "defrost", "deft", "defuse", "defy", "degree",
+	"delay", "delayed", "delegate", "delete", "deli", "delicacy", "delicate",
+	"delicious", "delight", "delighted", "delirious", "deliver", "delivered",
+	"deliverer", "delta", "delude", "deluge", "deluxe", "demand", "demanded",
+	"demeanor", "demise", "demo", "demolish", "demure", "denied", "denim",
+	"denizen", "denote", "denounce", "dense", "dental", "dentist", "deny",
+	"deodorant", "depart", "departed", "departure", "depend", "depict",
+	"depicted", "depiction", "deplete", "deploy", "depose", "deposit",
+	"deposited", "depot", "depth", "deputy", "derail", "derby", "derelict",
+	"derived", "dervish", "descend", "descent", "describe", "described",
+	"desert", "deserted", "deserve", "deserved", "design", "designed",
+	"designer", "desirable", "desire", "desired", "desk", "desktop",
+	"desolate", "despair", "despise", "dessert", "destined", "destiny",
+	"destroy", "detach", "detail", "detailed", "detect", "detected",
+	"detective", "detergent", "determine", "detest", "detour", "develop",
+	"device", "devious", "devised", "devote", "devoted", "devour", "dewberry",
+	"dewdrop", "dexterity", "diagnose", "diagonal", "diagram", "dial",
+	"dialect", "dialog", "dialysis", "diameter", "diamond", "diaper", "diary",
+	"diatom", "dice", "diced", "dictate", "dictated", "didactic", "diesel",
+	"diet", "differ", "diffuse", "digest", "digested", "digestion", "digit",
+	"digital", "dignified", "dignity", "digress", "dilemma", "diligence",
+	"diligent", "dill", "dime", "dimension", "diminish", "dimmed", "dimple",
+	"dimpled", "dined", "diner", "dinette", "dinghy", "dingo", "dinner",
+	"dinosaur", "dioxide", "diploma", "diplomat", "dipped", "dipper",
+	"dipstick", "direct", "directed", "directory", "dirigible", "dirt",
+	"disagree", "disc", "discard", "discern", "discharge", "disciple",
+	"discount", "discover", "discreet", "discus", "discuss", "discussed",
+	"disguise", "dish", "dishcloth", "dished", "dishpan", "disk", "dislike",
+	"dismal", "dismiss", "dismissed", "dismount", "dispatch", "dispense",
+	"disperse", "display", "displayed", "dispute", "disputed", "dissolve",
+	"dissolved", "distant", "distill", "distilled", "distinct", "distort",
+	"distress", "district", "ditch", "ditty", "diva", "dive", "diver",
+	"diverse", "divert", "divide", "divided", "dividend", "divine", "diving",
+	"divinity", "divot", "dizzy", "docile", "dock", "docked", "dockyard",
+	"doctor", "doctrine", "document", "dodge", "dodged", "doe", "dogfish",
+	"doghouse", "dogma", "dogwood", "doily", "doldrums", "dollhouse", "dolly",
+	"dolphin", "domain", "dome", "domestic", "domino", "donate", "donated",
+	"donkey", "donor", "donut", "doodle", "doodled", "door", "doorbell",
+	"doorframe", "doorknob", "doormat", "doorstep", "doorway", "dormant",
+	"dormitory", "dose", "dossier", "dot", "doted", "dotted", "double",
+	"doubled", "doubloon", "doubt", "dough", "doughnut", "doused", "dove",
+	"dovetail", "dowel", "downcast", "downhill", "download", "downpour",
+	"downright", "downtime", "downtown", "downturn", "downward", "downwind",
+	"dowry", "doze", "dozed", "dozen", "draft", "drafted", "drag", "dragged",
+	"dragon", "dragonfly", "drain", "drainage", "drained", "drainpipe",
+	"drama", "dramatic", "drape", "draped", "drapery", "drastic", "draw",
+	"drawer", "drawing", "drawled", "dread", "dreaded", "dreadful", "dream",
+	"dreamed", "dreamer", "dreamy", "dreary", "dredge", "dredged", "drench",
+	"drenched", "dress", "dressage", "dressed", "dresser", "dribble",
+	"dribbled", "drift", "drifted", "driftwood", "drill", "drilled", "drink",
+	"drinkable", "drip", "dripping", "drive", "driver", "driveway", "drizzle",
+	"drizzled", "dromedary", "drone", "drool", "droop", "drooped", "drop",
+	"dropped", "dropper", "drought", "drove", "drowsed", "drowsy", "drum",
+	"drumbeat", "drummed", "drumstick", "dry", "dryer", "drywall", "dual",
+	"dubbed", "dubious", "duck", "ducked", "duckling", "duckweed", "duct",
+	"dude", "duel", "dueled", "duet", "duffel", "dug", "dugout", "duke",
+	"dulcimer", "dull", "dulled", "dumbbell", "dumpling", "dumpster", "dune",
+	"dungarees", "dungeon", "duplex", "durable", "during", "dusk", "dusky",
+	"dust", "dusted", "dustpan", "dutiful", "duty", "duvet", "dwarf", "dwell",
+	"dwelled", "dwelling", "dwindled", "dye", "dyed", "dynamic", "dynamo",
+	"dynasty", "each", "eager", "eagle", "ear", "earache", "eardrum",
+	"earful", "earl", "earliest", "early", "earmuff", "earn", "earned",
+	"earnest", "earnings", "earring", "earth", "earthen", "earthly",
+	"earthworm", "earthy", "eased", "easel", "easement", "easily", "east",
+	"eastbound", "eastern", "easy", "easygoing", "eatery", "eavesdrop", "ebb",
+	"ebony", "eccentric", "echelon", "echo", "echoed", "eclair", "eclipse",
+	"ecology", "economic", "economy", "ecstatic", "eddy", "edge", "edged",
+	"edible", "edict", "edifice", "edit", "edited", "edition", "editor",
+	"educate", "educated", "educator", "eel", "eerie", "effect", "effective",
+	"efficient", "effigy", "effort", "egg", "eggcup", "eggnog", "eggplant",
+	"eggshell", "ego", "egret", "eiderdown", "eight", "eighteen", "eighty",
+	"either", "eject", "ejected", "ejection", "elaborate", "elastic",
+	"elated", "elation", "elbow", "elder", "elect", "elected", "electric",
+	"electron", "elegance", "elegant", "element", "elephant", "elevate",
+	"elevated", "elevator", "eleven", "eleventh", "elf", "elite", "elixir",
+	"elk", "ellipse", "elm", "elocution", "elope", "eloquent", "else",
+	"elsewhere", "elude", "elusive", "email", "embargo", "embark", "embassy",
+	"embedded", "embellish", "ember", "emblem", "embody", "embolden",
+	"embrace", "embraced", "embroider", "emerald", "emerge", "emerged",
+	"emeritus", "eminent", "emission", "emoticon", "emotion", "empathy",
+	"emperor", "emphasis", "emphatic", "empire", "employ", "employee",
+	"employer", "emporium", "empower", "emptied", "empty", "emu", "emulate",
+	"enable", "enabled", "enacted", "enamel", "encase", "encased", "enchant",
+	"enchilada", "encircle", "enclose", "enclosed", "encoded", "encompass",
+	"encore", "encounter", "encrypt", "endeavor", "ended", "endgame",
+	"endless", "endnote", "endorse", "endorsed", "endpoint", "endurance",
+	"endure", "endured", "energetic", "energized", "energy", "enforce",
+	"engage", "engaged", "engaging", "engine", "engineer", "engrave",
+	"engraved", "enhance", "enigma", "enjoy", "enjoyable", "enjoyed",
+	"enlarge", "enlarged", "enlighten", "enlist", "enlisted", "enliven",
+	"enmity", "ennoble", "enormous", "enough", "enquire", "enrage", "enrich",
+	"enriched", "enroll", "enrolled", "ensemble", "ensign", "ensure",
+	"ensured", "entangle", "enter", "entered", "enthrall", "enthuse",
+	"entice", "entire", "entitle", "entitled", "entourage", "entrance",
+	"entree", "entrust", "entry", "entwine", "enumerate", "envelope",
+	"envied", "envious", "envision", "envoy", "envy", "enzyme", "epaulet",
+	"ephemeral", "epic", "epilogue", "episode", "epitome", "epoch", "equal",
+	"equaled", "equation", "equator", "equine", "equinox", "equip",
+	"equipped", "equity", "era", "erase", "erased", "eraser", "erect",
+	"erected", "ermine", "erode", "errand", "errant", "error", "erudite",
+	"erupt", "escalate", "escalator", "escapade", "escape", "escaped",
+	"eschew", "escort", "escorted", "espresso", "essay", "essence",
+	"essential", "establish", "estate", "esteem", "esteemed", "estimate",
+	"estuary", "etch", "etched", "eternal", "ethics", "euphoria", "evade",
+	"evaded", "evaluate", "evaporate", "evasive", "even", "evened", "evening",
+	"event", "eventful", "eventual", "ever", "everglade", "evergreen",
+	"every", "everyday", "everyone", "evict", "evicted", "evidence",
+	"evident", "evil", "evoke", "evolve", "evolved", "exact", "exacted",
+	"exacting", "exalted", "exam", "examine", "examined", "example",
+	"excavate", "exceed", "exceeded", "excel", "excelled", "excellent",
+	"except", "excerpt", "excess", "exchange", "exchanged", "excite",
+	"excited", "exciting", "exclaim", "exclusive", "excursion", "excuse",
+	"excused", "executive", "exemplary", "exempt", "exercise", "exert",
+	"exerted", "exhale", "exhaled", "exhaust", "exhibit", "exhibited",
+	"exhibitor", "exile", "exist", "existed", "exit", "exited", "exodus",
+	"exotic", "expand", "expanded", "expanse", "expect", "expected",
+	"expedite", "expense", "expert", "expertise", "expire", "expired",
+	"explain", "explained", "explicit", "explode", "exploded", "exploit",
+	"explore", "explored", "exponent", "export", "exported", "expose",
+	"exposed", "express", "expressed", "exquisite", "extend", "extended",
+	"extent", "extinct", "extol", "extra", "extract", "extrovert",
+	"exuberant", "eyeball", "eyebrow", "eyeglass", "eyelash", "eyelet",
+	"eyelid", "eyeliner", "eyepiece", "eyesight", "fable", "fabric",
+	"fabulous", "facade", "face", "facelift", "facet", "facetious", "facial",
+	"facility", "facsimile", "fact", "factor", "factory", "factual",
+	"faculty", "fade", "faded", "fading", "fail", "failed", "faint",
+	"fainted", "fair", "fairness", "fairway", "fairy", "faith", "faithful",
+	"falcon", "fall", "fallible", "fallow", "false", "falsetto", "fame",
+	"familiar", "family", "famous", "fanciful", "fancy", "fanfare", "fang",
+	"fanlight", "fanned", "fantasy", "faraway", "fare", "farewell", "farm",
+	"farmed", "farmer", "farmhouse", "farmland", "farmstead", "farmyard",
+	"farther", "fascinate", "fastball", "fasted", "fasten", "fastened",
+	"fated", "fatherly", "fathom", "fatigue", "faucet", "fault", "fauna",
+	"favor", "favorable", "favored", "favorite", "fawn", "faxed", "feared",
+	"fearless", "feasible", "feast", "feasted", "feather", "feathered",
+	"feature", "featured", "fed", "federal", "fee", "feeble", "feed",
+	"feedback", "feel", "feisty", "felicity", "feline", "fellow", "felt",
+	"female", "fence", "fenced", "fencing", "fended", "fender", "fennel",
+	"ferment", "fermented", "fern", "ferocious", "ferret", "ferrous", "ferry",
+	"fertile", "festival", "festive", "festoon", "fetch", "fetched", "fever",
+	"feverish", "fiber", "fiction", "fiddle", "fiddler", "fidelity", "field",
+	"fielded", "fiery", "fiesta", "fifteen", "fifteenth", "fifty", "fig",
+	"figment", "figure", "figured", "figurine", "filament", "filbert", "file",
+	"filed", "filigree", "filled", "fillet", "filly", "filmed", "filmstrip",
+	"filter", "filtered", "final", "finale", "finance", "financed", "finch",
+	"finder", "finding", "fined", "finesse", "finger", "fingered",
+	"fingertip", "finish", "finished", "finite", "fir", "fire", "fireball",
+	"firebird", "fireboat", "fired", "firefly", "firehouse", "firelight",
+	"fireman", "fireplace", "fireproof", "fireside", "firewood", "firework",
+	"firm", "firmed", "firmware", "first", "fish", "fishbowl", "fished",
+	"fisher", "fishhook", "fishnet", "fissure", "fist", "fitful", "fitness",
+	"fitted", "five", "fixable", "fixated", "fixed", "fixture", "fizz",
+	"fizzled", "flag", "flagged", "flagpole", "flagship", "flagstone",
+	"flair", "flake", "flaked", "flame", "flamed", "flamenco", "flamingo",
+	"flanked", "flannel", "flap", "flapjack", "flapped", "flare", "flared",
+	"flash", "flashbulb", "flashed", "flask", "flat", "flatbed", "flatten",
+	"flattened", "flatware", "flavor", "flavored", "flaw", "flawless", "flax",
+	"flea", "fledgling", "fleece", "fleet", "flesh", "flex", "flexed",
+	"flexible", "flick", "flicked", "flight", "flimsy", "flinch", "fling",
+	"flint", "flip", "flipped", "flipper", "flirt", "float", "floated",
+	"flock", "flocked", "flood", "flooded", "floor", "floored", "flopped",
+	"flora", "floral", "flossed", "flotilla", "flounder", "flour", "flourish",
+	"flow", "flowed", "flower", "flowerpot", "fluent", "fluff", "fluffed",
+	"fluffy", "fluid", "fluke", "fluorite", "flurry", "flush", "flushed",
+	"flute", "fluted", "flyer", "flying", "flyover", "flypaper", "flywheel",
+	"foam", "foamed", "focal", "focus", "focused", "fodder", "fog", "fogged",
+	"foggy", "foghorn", "foil", "foiled", "fold", "folded", "folder",
+	"foliage", "folk", "folklore", "follow", "followed", "follower", "fond",
+	"fondness", "fondue", "font", "food", "foodie", "fooled", "foot",
+	"football", "footed", "foothill", "footing", "footnote", "footpath",
+	"footprint", "footrest", "footstep", "footstool", "footwear", "forage",
+	"force", "forced", "forceful", "forded", "forearm", "forecast",
+	"forehand", "forehead", "foreman", "foremost", "foresee", "foresight",
+	"forest", "forester", "foretell", "forever", "forfeit", "forge", "forged",
+	"forgery", "forget", "forgive", "forgotten", "fork", "forklift", "form",
+	"formal", "format", "formation", "formative", "formed", "former",
+	"formula", "fort", "forth", "fortify", "fortitude", "fortnight",
+	"fortress", "fortunate", "fortune", "forty", "forum", "forward", "fossil",
+	"foster", "fostered", "fouled", "founded", "foundry", "fountain", "four",
+	"fourfold", "fourteen", "fox", "foxglove", "foxhole", "foyer", "fraction",
+	"fragile", "frame", "framed", "frank", "fraternal", "freckle", "freckled",
+	"free", "freebie", "freed", "freedom", "freehand", "freestyle", "freeway",
+	"freeze", "freight", "frenzy", "fresh", "freshen", "freshened",
+	"freshman", "fretful", "fretted", "friction", "fridge", "fried", "friend",
+	"friendly", "frigate", "fright", "fringe", "fringed", "frisky",
+	"frivolous", "frizzed", "frog", "frolic", "front", "frontage", "frontier",
+	"frost", "frosted", "frosting", "frosty", "froth", "frothed", "frowned",
+	"frozen", "frugal", "fruit", "fruitful", "fuchsia", "fudge", "fuel",
+	"fueled", "fugitive", "fulcrum", "fulfill", "fulfilled", "full",
+	"fullback", "fumble", "fumbled", "fumigate", "fun", "function", "fund",
+	"funded", "funfair", "fungus", "funnel", "funny", "fur", "furled",
+	"furlong", "furlough", "furnace", "furnish", "furnished", "furrow",
+	"further", "fury", "fuse", "fused", "fusion", "fussed", "fusspot",
+	"futon", "future", "fuzzy", "gable", "gabled", "gadget", "gaggle", "gain",
+	"gained", "gainful", "gala", "galaxy", "gale", "gallant", "galleon",
+	"gallery", "galley", "gallon", "gallop", "galloped", "galore",
+	"galvanize", "gambit", "game", "gamma", "gander", "gangway", "gantry",
+	"gap", "garage", "garden", "gardened", "gargled", "garland", "garlic",
+	"garment", "garner", "garnet", "garnish", "garnished", "garrison",
+	"garter", "gasket", "gaslight", "gastric", "gate", "gateau", "gatehouse",
+	"gatepost", "gateway", "gather", "gathered", "gaucho", "gauge", "gauged",
+	"gauze", "gavel", "gazebo", "gazed", "gazelle", "gazette", "gear",
+	"gearbox", "geared", "gearshift", "gecko", "gel", "gelatin", "gelled",
+	"gem", "gemstone", "gender", "gene", "genealogy", "general", "generator",
+	"generous", "genesis", "genial", "genius", "genre", "gentility", "gentle",
+	"gentleman", "genuine", "geology", "geranium", "gerbil", "germ",
+	"gesture", "geyser", "gherkin", "ghost", "giant", "gift", "gifted",
+	"gigantic", "giggle", "giggled", "gilded", "gimmick", "ginger", "gingham",
+	"ginseng", "giraffe", "girder", "girdled", "girth", "give", "glacier",
+	"glad", "glade", "glamour", "glance", "gland", "glare", "glass",
+	"glassware", "glaze", "glazed", "gleam", "gleamed", "gleaned", "glee",
+	"gleeful", "glen", "glide", "glided", "glider", "glimmer", "glimmered",
+	"glimpse", "glint", "glinted", "glisten", "glistened", "glitch",
+	"glitter", "glittered", "gloated", "global", "globe", "gloom", "glorious",
+	"glory", "gloss", "glossary", "glossy", "glove", "glow", "glowed",
+	"glowworm", "glucose", "glue", "glued", "gnarled", "gnat", "gnawed",
+	"gnome", "goal", "goalie", "goat", "goatee", "gobble", "gobbled",
+	"goblet", "goblin", "godchild", "godsend", "goggles", "golden",
+	"goldfish", "goldsmith", "golf", "golfed", "golfer", "gondola",
+	"gondolier", "gong", "goodbye", "goodness", "goodwill", "gooey", "goose",
+	"gopher", "gorge", "gorgeous", "gorilla", "gosling", "gospel", "gossip",
+	"gossiped", "gouda", "gourd", "gourmand", "gourmet", "govern", "governed",
+	"gown", "grab", "grabbed", "grace", "graced", "graceful", "gracious",
+	"grade", "graded", "gradual", "graduate", "graft", "grafted", "grain",
+	"grained", "grammar", "granary", "grand", "grandeur", "grandson",
+	"granite", "granola", "grant", "granted", "granular", "grape",
+	"grapevine", "graph", "graphed", "graphic", "grasp", "grasped", "grass",
+	"grassland", "grated", "grateful", "gratitude", "gravel", "graveled",
+	"gravity", "gravy", "gray", "graze", "grazed", "grease", "greased",
+	"great", "greatcoat", "green", "greenery", "greet", "greeted", "greeting",
+	"grid", "gridded", "griddle", "griffin", "grill", "grilled", "grin",
+	"grind", "grinned", "grip", "gripped", "gristle", "grit", "grizzly",
+	"groan", "groaned", "grocer", "groceries", "grocery", "grommet", "groom",
+	"groomed", "groove", "grooved", "gross", "grotto", "ground", "group",
+	"grouped", "grouse", "grove", "grow", "growl", "growled", "growth",
+	"grub", "grubby", "gruel", "gruff", "grumble", "grumbled", "grunt",
+	"grunted", "guarantee", "guard", "guarded", "guardian", "guava", "guess",
+	"guessed", "guest", "guide", "guidebook", "guided", "guideline", "guild",
+	"guileless", "guinea", "guitar", "gulf", "gull", "gullible", "gully",
+	"gulped", "gum", "gumbo", "gumdrop", "gumption", "gunwale", "guppy",
+	"gurgled", "gushed", "gust", "gusto", "gutter", "gym", "gymnast",
+	"gypsum", "gyro", "habit", "habitat", "hacienda", "hacksaw", "haddock",
+	"haiku", "hail", "hailed", "hailstone", "hair", "hairbrush", "haircut",
+	"hairdo", "hairline", "hairnet", "hairpin", "halcyon", "halftime",
+	"halfway", "halibut", "hall", "hallmark", "hallway", "halo", "halt",
+	"halter", "halve", "halved", "hamburger", "hamlet", "hammer", "hammered",
+	"hammock", "hamper", "hamster", "hand", "handbag", "handball", "handbook",
+	"handcart", "handcraft", "handful", "handheld", "handiwork", "handle",
+	"handlebar", "handled", "handmade", "handout", "handpick", "handrail",
+	"handset", "handshake", "handsome", "handstand", "handwork", "handy",
+	"handyman", "hangar", "hanger", "hangnail", "hankie", "haphazard",
+	"happen", "happiness", "happy", "harbor", "hardback", "hardcover",
+	"hardhat", "hardly", "hardware", "hardwood", "hardy", "hare", "harebell",
+	"harmonica", "harmony", "harness", "harp", "harpoon", "harrier", "harrow",
+	"harvest", "hashtag", "haste", "hatband", "hatbox", "hatch", "hatchback",
+	"hatched", "hatchet", "hauled", "haunt", "haunted", "haven", "haversack",
+	"havoc", "hawk", "hawthorn", "hay", "haycock", "hayloft", "hayride",
+	"haystack", "hazard", "haze", "hazel", "hazy", "headband", "headboard",
+	"headdress", "headed", "header", "heading", "headlamp", "headland",
+	"headlight", "headline", "headlong", "headphone", "headrest", "headroom",
+	"headstand", "headstone", "headway", "headwind", "heal", "healed",
+	"health", "healthy", "heap", "heaped", "hearsay", "heart", "heartbeat",
+	"hearted", "heartfelt", "hearth", "heartland", "hearty", "heat", "heated",
+	"heater", "heath", "heatwave", "heaved", "heaven", "heavenly", "heavy",
+	"hectare", "hedge", "hedged", "hedgehog", "hedgerow", "heeded", "heedful",
+	"heel", "height", "heir", "heirloom", "helipad", "helium", "helix",
+	"helmet", "help", "helped", "helpful", "helpmate", "hemlock", "hemmed",
+	"hen", "heptagon", "herald", "herb", "herbal", "herbivore", "herd",
+	"herded", "hereafter", "heritage", "hermit", "hero", "heroic", "heroine",
+	"heron", "herring", "hesitant", "hexagon", "hibiscus", "hiccup",
+	"hiccuped", "hidden", "hide", "hideaway", "hierarchy", "high", "highbrow",
+	"highchair", "highland", "highlight", "highway", "hike", "hiked", "hiker",
+	"hilarious", "hill", "hillock", "hillside", "hilltop", "hindsight",
+	"hinge", "hinged", "hint", "hinted", "hip", "hippo", "hire", "hired",
+	"hissed", "historian", "history", "hitchhike", "hive", "hoard", "hoarse",
+	"hobby", "hobbyist", "hobnail", "hockey", "hogwash", "hoist", "hoisted",
+	"hold", "holdall", "holiday", "hollow", "holly", "hollyhock", "hologram",
+	"holster", "home", "homebody", "homeland", "homemade", "homespun",
+	"homestead", "hometown", "homeward", "homework", "honest", "honey",
+	"honeybee", "honeycomb", "honeydew", "honeymoon", "honked", "honor",
+	"honorable", "hood", "hooded", "hoodie", "hoof", "hook", "hooked", "hoop",
+	"hooted", "hop", "hope", "hopeful", "hopped", "hopscotch", "horizon",
+	"horn", "hornet", "hornpipe", "horoscope", "horse", "horseback",
+	"horsefly", "horseman", "horseshoe", "hose", "hospice", "hospital",
+	"host", "hosted", "hostel", "hostess", "hotcake", "hotdog", "hotel",
+	"hotplate", "hound", "hour", "hourglass", "house", "houseboat",
+	"household", "housework", "hover", "hovered", "howdy", "howl", "howled",
+	"hub", "hubbub", "hubcap", "huddle", "huddled", "hue", "hug", "huge",
+	"hugged", "hull", "hulled", "human", "humane", "humble", "humdrum",
+	"humid", "hummed", "hummus", "humor", "humored", "humorous", "hunch",
+	"hunched", "hundred", "hunger", "hungry", "hunter", "hurdle", "hurricane",
+	"hurried", "hurry", "husband", "hushed", "husk", "huskily", "husky",
+	"hustled", "hut", "hyacinth", "hybrid", "hydrant", "hydrogen", "hygiene",
+	"hymn", "hyphen", "ice", "iceberg", "icebox", "iced", "icicle", "icing",
+	"icon", "iconic", "icy", "idea", "ideal", "idealist", "identical",
+	"identify", "idiom", "idle", "idled", "idler", "idol", "idyllic", "igloo",
+	"ignite", "ignited", "ignore", "iguana", "illegible", "illusion", "image",
+	"imagery", "imaginary", "imagine", "imagined", "imbue", "imitate",
+	"immense", "immerse", "immortal", "immune", "impact", "impart",
+	"impartial", "impel", "imperial", "impetus", "implement", "implore",
+	"impolite", "import", "imported", "importer", "impose", "imposing",
+	"impress", "imprint", "improper", "improve", "improved", "improvise",
+	"impulse", "inaction", "inborn", "inbox", "incense", "inception", "inch",
+	"inched", "inchworm", "incisor", "incline", "include", "income",
+	"increase", "increment", "incubate", "indeed", "indented", "index",
+	"indexer", "indicate", "indigo", "indoor", "induce", "inducted",
+	"industry", "inert", "inertia", "infant", "infantry", "infinite",
+	"infinity", "inflate", "inflated", "inflow", "inform", "informal",
+	"informed", "infuse", "ingenious", "ingot", "ingrained", "inhabit",
+	"inhale", "inhaled", "inherit", "initial", "inject", "injury", "ink",
+	"inkblot", "inked", "inkjet", "inkling", "inkwell", "inlaid", "inland",
+	"inlay", "inlet", "inn", "innate", "inner", "innkeeper", "innocent",
+	"input", "inquire", "inquiry", "insect", "insert", "inserted",
+	"insertion", "inside", "insider", "insight", "insignia", "insist",
+	"insole", "inspect", "inspector", "inspire", "inspired", "install",
+	"installed", "instance", "instant", "instead", "instinct", "instruct",
+	"insulate", "insulin", "insult", "insured", "intact", "intake", "integer",
+	"intellect", "intend", "intended", "intense", "intent", "intercom",
+	"interest", "interim", "interior", "intern", "internal", "interval",
+	"interview", "into", "intrepid", "intricate", "intrigue", "introvert",
+	"intuition", "invade", "invader", "invent", "invented", "inventor",
+	"inverse", "invest", "invisible", "invite", "invited", "invoice",
+	"involve", "inward", "iodine", "ionic", "iris", "iron", "ironclad",
+	"ironed", "ironing", "ironwork", "irony", "island", "islander", "isle",
+	"isotope", "issue", "itch", "itched", "item", "itemize", "itinerary",
+	"ivory", "ivy", "jab", "jabbed", "jabber", "jackal", "jackdaw", "jacket",
+	"jackfruit", "jackknife", "jackpot", "jacuzzi", "jade", "jaded", "jaguar",
+	"jalapeno", "jalopy", "jam", "jamboree", "jammed", "jangle", "janitor",
+	"jar", "jargon", "jarred", "jasmine", "jasper", "jaunt", "javelin", "jaw",
+	"jawbone", "jaywalk", "jazz", "jazzed", "jazzy", "jealous", "jeans",
+	"jeep", "jelly", "jellybean", "jellyfish", "jerky", "jersey", "jested",
+	"jester", "jet", "jetliner", "jetsam", "jetted", "jettison", "jetty",
+	"jewel", "jeweler", "jewelry", "jiffy", "jiggled", "jigsaw", "jingle",
+	"jingled", "jitterbug", "jobless", "jockey", "jocular", "jodhpurs", "jog",
+	"jogged", "jogger", "join", "joined", "joinery", "joint", "jointly",
+	"joke", "joked", "jokester", "jolly", "jolted", "jonquil", "jostle",
+	"jotted", "jotter", "journal", "journey", "jovial", "joy", "joyful",
+	"joyous", "joystick", "jubilant", "jubilee", "judge", "judged",
+	"judicial", "judo", "jug", "juggle", "juggled", "juggler", "juice",
+	"juicer", "juicy", "jukebox", "julep", "jumble", "jumbled", "jumbo",
+	"jump", "jumped", "jumper", "jumpsuit", "junction", "jungle", "junior",
+	"juniper", "junk", "junket", "juror", "jury", "just", "justice",
+	"justify", "jute", "juvenile", "kabob", "kale", "kangaroo", "kaolin",
+	"karaoke", "karate", "kayak", "kayaker", "kebab", "keel", "keen",
+	"keenly", "keeper", "keepsake", "kelp", "kennel", "kerchief", "kernel",
+	"kerosene", "kestrel", "ketchup", "kettle", "key", "keyboard", "keycard",
+	"keyhole", "keynote", "keypad", "keystone", "keyword", "khaki", "kick",
+	"kickback", "kickoff", "kickstand", "kiddo", "kidney", "kiln", "kilobyte",
+	"kilogram", "kilometer", "kilowatt", "kilt", "kimono", "kind", "kindle",
+	"kindling", "kindly", "kindness", "kindred", "kinetic", "king", "kingdom",
+	"kingpin", "kinship", "kiosk", "kipper", "kismet", "kitchen", "kite",
+	"kitten", "kitty", "kiwi", "knack", "knapsack", "knead", "knee",
+	"kneecap", "kneel", "knickers", "knife", "knight", "knit", "knitting",
+	"knitwear", "knob", "knock", "knockout", "knoll", "knot", "knothole",
+	"knotty", "know", "knowhow", "knowledge", "knuckle", "koala", "kohlrabi",
+	"krypton", "kudos", "kumquat", "label", "labor", "laborer", "labyrinth",
+	"lace", "lacquer", "lacrosse", "ladder", "ladle", "lady", "ladybug",
+	"lagoon", "lake", "lakeside", "lamb", "lambskin", "lament", "lamp",
+	"lamplight", "lampshade", "lance", "land", "landfill", "landing",
+	"landlady", "landlord", "landmark", "landmass", "landscape", "landslide",
+	"lane", "language", "lantern", "lanyard", "lap", "lapel", "laptop",
+	"larch", "larder", "large", "lark", "larkspur", "larva", "lasagna",
+	"laser", "lasso", "last", "latch", "late", "latecomer", "lately",
+	"lateral", "lathe", "lather", "latitude", "latte", "lattice", "laugh",
+	"laughter", "launch", "launchpad", "laundry", "laureate", "laurel",
+	"lava", "lavender", "lavish", "lawmaker", "lawn", "lawnmower", "lawyer",
+	"layaway", "layer", "layered", "layout", "lazy", "lead", "leader", "leaf",
+	"leaflet", "leafy", "league", "lean", "leap", "leapfrog", "learn",
+	"learner", "lease", "leash", "least", "leather", "leathery", "leave",
+	"lectern", "lecture", "lecturer", "ledge", "ledger", "leek", "leeward",
+	"left", "leftover", "legacy", "legal", "legend", "legible", "legion",
+	"legislate", "legroom", "legume", "leisure", "lemon", "lemonade", "lend",
+	"length", "lengthen", "lenient", "lens", "lentil", "leopard", "leotard",
+	"lesson", "letter", "lettuce", "levee", "level", "lever", "leverage",
+	"lexicon", "liberty", "librarian", "library", "license", "lichen", "lid",
+	"life", "lifeboat", "lifeguard", "lifeline", "lifelong", "lifespan",
+	"lifestyle", "lifetime", "lift", "ligament", "light", "lightning",
+	"likable", "likeness", "lilac", "lily", "limb", "limber", "lime",
+	"limelight", "limerick", "limestone", "limit", "limitless", "limousine",
+	"limp", "linchpin", "linden", "line", "lineage", "linear", "linen",
+	"liner", "linger", "lingo", "linguist", "lining", "link", "linoleum",
+	"lint", "lion", "lionfish", "lionheart", "lip", "lipstick", "liquefy",
+	"liquid", "list", "listen", "listener", "liter", "literacy", "literal",
+	"litmus", "little", "live", "lively", "liver", "livestock", "lizard",
+	"llama", "load", "loaf", "loafer", "loan", "loaner", "lobby", "lobster",
+	"local", "locale", "locate", "lock", "locker", "locket", "locksmith",
+	"lockstep", "lodestar", "lodge", "lodger", "loft", "loftily", "lofty",
+	"logbook", "logic", "logistics", "logo", "lollipop", "lone", "long",
+	"longboat", "longbow", "longhand", "longhouse", "longitude", "look",
+	"lookout", "loom", "loop", "loophole", "loose", "loosen", "lopsided",
+	"lord", "lordship", "lotion", "lottery", "lotus", "loud", "loudly",
+	"lounge", "love", "lovebird", "lovely", "lower", "lowland", "loyal",
+	"loyalty", "lozenge", "lubricant", "lucid", "luck", "luckily", "lucky",
+	"luggage", "lukewarm", "lullaby", "lumber", "luminous", "lump", "lunar",
+	"lunch", "lunchbox", "luncheon", "lung", "lure", "lurid", "lush",
+	"luster", "lustrous", "lute", "luxury", "lyric", "lyrical", "macaroni",
+	"macaroon", "machine", "machinery", "mackerel", "madcap", "madrigal",
+	"maestro", "magazine", "magenta", "magic", "magician", "magnesium",
+	"magnet", "magnetic", "magnify", "magnolia", "magpie", "mahjong",
+	"mahogany", "maid", "maiden", "mail", "mailbag", "mailbox", "mailroom",
+	"main", "mainframe", "mainland", "mainsail", "mainstay", "maize",
+	"majestic", "major", "majorette", "make", "makeover", "maker",
+	"malachite", "mallard", "mallet", "malt", "mammal", "mammoth", "manage",
+	"manager", "mandarin", "mandolin", "mane", "maneuver", "mangle", "mango",
+	"manhole", "manicure", "manifest", "manifold", "manner", "manor",
+	"mansion", "mantel", "mantis", "mantle", "mantra", "manual", "maple",
+	"mapmaker", "marathon", "marble", "march", "mare", "margarine", "margin",
+	"marigold", "marina", "marinade", "marine", "maritime", "marjoram",
+	"mark", "markdown", "market", "marketer", "marksman", "marmalade",
+	"marmot", "maroon", "marquee", "marrow", "marsh", "marshal", "marshland",
+	"marsupial", "martial", "marvelous", "marzipan", "mascara", "mascot",
+	"mask", "mason", "masonry", "mast", "master", "masterful", "mat",
+	"matador", "match", "matchbook", "matchbox", "material", "matinee",
+	"matrix", "matter", "mattress", "mature", "maverick", "maximize",
+	"maximum", "mayfly", "mayor", "meadow", "meal", "mean", "meander",
+	"meantime", "measure", "meat", "meatball", "mechanic", "medal",
+	"medallion", "meddle", "media", "median", "mediator", "medical",
+	"medicine", "meditate", "medium", "medley", "meet", "megabyte",
+	"megaphone", "melodic", "melody", "melon", "melt", "meltdown", "member",
+	"membrane", "memento", "memo", "memoir", "memory", "menagerie", "mend",
+	"mentor", "menu", "merchant", "mercury", "mercy", "merge", "meridian",
+	"meringue", "merit", "mermaid", "merriment", "merry", "mesa", "mesh",
+	"mesmerize", "message", "metal", "metallic", "meteor", "meter", "method",
+	"metric", "metro", "metronome", "mezzanine", "microbe", "microchip",
+	"microwave", "midday", "middle", "midfield", "midland", "midnight",
+	"midpoint", "midst", "midsummer", "midweek", "midwinter", "might",
+	"mighty", "migrate", "mild", "mildew", "mile", "mileage", "milestone",
+	"milk", "milkmaid", "milkshake", "milkweed", "mill", "millpond",
+	"millstone", "mimic", "mimosa", "minaret", "mind", "mindful", "minefield",
+	"mineral", "miniature", "minibus", "minimal", "minimum", "minnow",
+	"minstrel", "mint", "mintage", "minute", "miracle", "mirage", "mirror",
+	"mirth", "mischief", "miser", "mistletoe", "misty", "mitten", "mix",
+	"mixer", "moat", "mobile", "moccasin", "mocha", "model", "modem",
+	"modern", "modernize", "modest", "modify", "modular", "module", "mohair",
+	"moist", "moisture", "molar", "molasses", "mold", "molecule", "moment",
+	"momentum", "monarch", "monastery", "monetary", "money", "mongoose",
+	"monitor", "monk", "monkey", "monocle", "monogram", "monolith",
+	"monorail", "monsoon", "monument", "mood", "moon", "moonbeam",
+	"moonlight", "moonstone", "moor", "moorland", "moose", "moped", "moraine",
+	"morale", "morning", "morsel", "mortar", "mosaic", "mosquito", "moss",
+	"mossy", "motel", "moth", "mother", "motherly", "motif", "motion",
+	"motive", "motor", "motorbike", "motorboat", "motto", "mound", "mount",
+	"mountain", "mouse", "mousetrap", "mousse", "mouth", "mouthful",
+	"movable", "move", "movie", "mower", "much", "mud", "muffin", "mug",
+	"mulberry", "mulch", "mule", "multiply", "mumble", "municipal", "mural",
+	"muralist", "murmur", "muscle", "muscular", "museum", "mushroom", "music",
+	"musical", "musician", "musketeer", "muskrat", "mustang", "mustard",
+	"mutable", "mutual", "muzzle", "myriad", "myrtle", "mystery", "mystic",
+	"myth", "nachos", "nail", "naive", "name", "namesake", "nanny", "napkin",
+	"narrate", "narrator", "narrow", "narwhal", "nasal", "nation", "national",
+	"native", "nativity", "natural", "nature", "nautical", "nautilus",
+	"navel", "navigate", "near", "nearby", "nearness", "neat", "neatly",
+	"nebula", "necessary", "neck", "necklace", "nectar", "nectarine", "need",
+	"needful", "needle", "negotiate", "neighbor", "neon", "nephew", "nerve",
+	"nest", "nestle", "net", "netball", "nettle", "network", "neutral",
+	"never", "new", "newborn", "newcomer", "newlywed", "news", "newsboy",
+	"newscast", "newspaper", "newsprint", "newsroom", "newsstand", "newt",
+	"next", "nibble", "nice", "nickel", "nickname", "niece", "night",
+	"nightcap", "nightfall", "nightgown", "nightly", "nimble", "nine",
+	"nineteen", "ninety", "nitrogen", "nobility", "noble", "nobody",
+	"nocturnal", "nod", "node", "noggin", "noise", "nomad", "nominee",
+	"nonstop", "noodle", "noon", "noonday", "normal", "north", "northern",
+	"nose", "nosebag", "nosegay", "notable", "notary", "notch", "note",
+	"notebook", "nothing", "notice", "noticed", "notion", "nougat", "novel",
+	"novella", "novelty", "novice", "nowadays", "nowhere", "nozzle", "nuance",
+	"nucleus", "nudge", "nugget", "number", "numeral", "numerous", "nurse",
+	"nursery", "nurture", "nutmeg", "nutrient", "nutshell", "nylon", "oak",
+	"oar", "oarsman", "oasis", "oat", "oatcake", "oatmeal", "obedient",
+	"obelisk", "obey", "obituary", "object", "objective", "oblige", "oblong",
+	"oboe", "oboist", "obscure", "observe", "observer", "obsidian",
+	"obstacle", "obtain", "obvious", "ocarina", "occasion", "occupant",
+	"occupy", "occur", "ocean", "ocelot", "octagon", "octave", "octopus",
+	"odd", "oddball", "oddity", "odometer", "odor", "odyssey", "offbeat",
+	"offer", "offering", "offhand", "office", "officer", "offline", "offset",
+	"offshoot", "offshore", "offspring", "offstage", "often", "oil",
+	"oilcloth", "oilskin", "oily", "ointment", "okay", "okra", "old",
+	"oleander", "olive", "omelet", "omen", "omit", "omnibus", "onboard",
+	"once", "oncoming", "onetime", "ongoing", "onion", "online", "onlooker",
+	"only", "onrush", "onscreen", "onset", "onshore", "onstage", "onward",
+	"opal", "opaque", "open", "opener", "openness", "opera", "operate",
+	"operetta", "opinion", "opossum", "opponent", "oppose", "optic",
+	"optician", "optimal", "optimist", "option", "optional", "opulent",
+	"oracle", "orange", "orangery", "orator", "orbit", "orbital", "orchard",
+	"orchestra", "orchid", "order", "ordinal", "ordinary", "oregano", "organ",
+	"organic", "organist", "organza", "oriental", "origami", "origin",
+	"original", "oriole", "ornament", "ornate", "orphan", "orphanage",
+	"oscillate", "osprey", "ostrich", "other", "otter", "ottoman", "ounce",
+	"ourselves", "outback", "outboard", "outbound", "outburst", "outcome",
+	"outcry", "outdated", "outdoor", "outer", "outfield", "outfit",
+	"outgoing", "outgrow", "outing", "outlast", "outlaw", "outlet", "outline",
+	"outlook", "outpost", "output", "outrigger", "outright", "outset",
+	"outshine", "outside", "outskirts", "outspoken", "outstrip", "outward",
+	"oval", "ovation", "oven", "over", "overall", "overalls", "overboard",
+	"overcast", "overcoat", "overdue", "overflow", "overgrown", "overhaul",
+	"overhead", "overjoyed", "overland", "overlap", "overlook", "overnight",
+	"overpass", "overseas", "oversight", "overtime", "overture", "overview",
+	"owl", "owner", "oxbow", "oxford", "oxygen", "oyster", "ozone", "pace",
+	"pacific", "pacifier", "pack", "package", "packet", "pad", "paddle",
+	"paddock", "padlock", "page", "pageant", "pagoda", "pail", "paint",
+	"paintbox", "painter", "paintwork", "pair", "pajamas", "palace", "palate",
+	"palatial", "pale", "palette", "palisade", "palm", "palmtop", "pamphlet",
+	"pan", "pancake", "pancreas", "panda", "pandemic", "panel", "panic",
+	"panorama", "pansy", "panther", "pantry", "papaya", "paper", "paperback",
+	"paperclip", "papyrus", "parable", "parachute", "parade", "paradise",
+	"paradox", "paragon", "paragraph", "parakeet", "parallel", "paramedic",
+	"parasol", "parcel", "parchment", "pardon", "parent", "parental",
+	"parish", "park", "parka", "parkland", "parkway", "parlance", "parlor",
+	"parmesan", "parody", "parquet", "parrot", "parsley", "parsnip", "part",
+	"partake", "particle", "partner", "partridge", "party", "pass", "passage",
+	"passenger", "passion", "passport", "password", "past", "pasta", "paste",
+	"pastel", "pastime", "pastry", "pasture", "patch", "patchwork", "patent",
+	"paternal", "path", "pathway", "patience", "patio", "patriarch", "patrol",
+	"patron", "pattern", "pauper", "pause", "pave", "pavement", "pavilion",
+	"paw", "paycheck", "payload", "payment", "payroll", "pea", "peace",
+	"peaceful", "peach", "peachy", "peacock", "peak", "peanut", "pear",
+	"pearl", "pearly", "pebble", "pecan", "peculiar", "pedal", "peddler",
+	"pedestal", "pedigree", "peephole", "pegboard", "pelican", "pellet",
+	"pen", "penalty", "pencil", "pendant", "pendulum", "penguin", "peninsula",
+	"penknife", "pennant", "penny", "pension", "pentagon", "penthouse",
+	"peony", "people", "pepper", "percent", "perch", "percolate", "perennial",
+	"perfect", "perforate", "perform", "perfume", "pergola", "perimeter",
+	"period", "periscope", "perky", "permanent", "permit", "perplex",
+	"persimmon", "person", "persona", "persuade", "petal", "petite",
+	"petition", "petrol", "petunia", "pew", "pewter", "phantom", "pharmacy",
+	"phase", "pheasant", "phoenix", "phone", "phonics", "photo", "photon",
+	"phrase", "physics", "pianist", "piano", "piccolo", "pick", "pickax",
+	"pickle", "picnic", "picture", "pie", "piece", "piecework", "pier",
+	"pierce", "pig", "pigeon", "piggyback", "piglet", "pigment", "pigtail",
+	"pike", "pile", "pilgrim", "pill", "pillar", "pillbox", "pillow", "pilot",
+	"pimento", "pinafore", "pinball", "pinch", "pine", "pineapple",
+	"pinecone", "pink", "pinnacle", "pinpoint", "pinstripe", "pint",
+	"pinwheel", "pioneer", "pipe", "pirate", "pistachio", "piston", "pitch",
+	"pitcher", "pitchfork", "pitstop", "pity", "pivot", "pixel", "pizza",
+	"placard", "place", "placid", "plain", "plaintive", "plan", "plane",
+	"planet", "planetary", "plank", "plankton", "planner", "plant",
+	"plantain", "plaque", "plaster", "plastic", "plate", "plateau",
+	"platform", "platinum", "platter", "play", "playback", "player",
+	"playful", "playhouse", "playmate", "playpen", "playroom", "playtime",
+	"plaza", "plea", "pleasant", "please", "pleat", "pledge", "plentiful",
+	"plenty", "pliable", "pliers", "plot", "plow", "pluck", "plug", "plum",
+	"plumage", "plumber", "plumbing", "plume", "plummet", "plump", "plunge",
+	"plural", "plus", "plush", "plywood", "pocket", "pocketful", "podcast",
+	"podium", "poem", "poet", "poetic", "point", "pointer", "poise", "polar",
+	"polaris", "pole", "polecat", "police", "policy", "polish", "polite",
+	"polka", "poll", "pollen", "polo", "polyester", "pomelo", "pompom",
+	"poncho", "pond", "ponder", "pontoon", "pony", "ponytail", "poodle",
+	"pool", "poolside", "popcorn", "poplar", "popover", "poppy", "popular",
+	"porcelain", "porch", "porcupine", "pore", "pork", "porridge", "port",
+	"portable", "portal", "porter", "portfolio", "portico", "portion",
+	"portrait", "pose", "position", "possible", "post", "postage", "postbox",
+	"postcard", "poster", "postman", "postpone", "posture", "pot", "potato",
+	"potion", "potluck", "potpie", "potter", "pottery", "pouch", "poultry",
+	"pound", "pour", "powder", "power", "practical", "practice", "prairie",
+	"praise", "praline", "prawn", "preamble", "precious", "precise",
+	"predict", "prefer", "prefix", "prelude", "premium", "prepare", "present",
+	"preserve", "press", "presto", "pretend", "pretty", "pretzel", "prevail",
+	"prevent", "price", "pride", "primary", "prime", "primrose", "prince",
+	"princess", "print", "printer", "prior", "prism", "private", "prize",
+	"probable", "probe", "problem", "proceed", "process", "produce",
+	"product", "profile", "profit", "program", "project", "promenade",
+	"promise", "prompt", "proof", "prop", "propeller", "proper", "property",
+	"prophet", "prose", "prospect", "protect", "protein", "proton", "proud",
+	"prove", "proverb", "provide", "provider", "prowess", "prudent", "prune",
+	"pudding", "puddle", "puffin", "pull", "pulley", "pullover", "pulsar",
+	"pulse", "puma", "pumice", "pump", "pumpkin", "punch", "punctual",
+	"pupil", "puppet", "puppy", "purchase", "purple", "purpose", "purse",
+	"pursue", "pursuit", "push", "pushcart", "puzzle", "pylon", "pyramid",
+	"quack", "quackery", "quadrant", "quadrille", "quagmire", "quail",
+	"quaint", "quaintly", "quake", "qualify", "quality", "quantity",
+	"quantum", "quarry", "quart", "quarter", "quartet", "quartz", "quasar",
+	"quatrain", "quaver", "quay", "quayside", "queasy", "queen", "quench",
+	"query", "quest", "question", "queue", "quibble", "quick", "quickstep",
+	"quiet", "quietude", "quill", "quilt", "quilted", "quince", "quinoa",
+	"quintet", "quipster", "quirk", "quirky", "quit", "quite", "quiver",
+	"quiz", "quizzical", "quota", "quote", "quotient", "rabbit", "raccoon",
+	"race", "racecar", "racehorse", "racer", "racetrack", "rack", "racket",
+	"racquet", "radar", "radiance", "radiant", "radiator", "radio", "radish",
+	"radius", "raffle", "raft", "rafter", "raftsman", "rage", "ragtime",
+	"raid", "rail", "railcar", "railing", "railroad", "railway", "rain",
+	"rainbow", "raincoat", "raindrop", "rainfall", "rainstorm", "rainwater",
+	"raise", "raisin", "rake", "rally", "ramble", "rambler", "rambling",
+	"ramekin", "ramp", "rampart", "ranch", "rancher", "random", "range",
+	"ranger", "rank", "rapid", "rapids", "rapport", "rapture", "rare",
+	"rarebit", "rascal", "rash", "raspberry", "ratchet", "rate", "rather",
+	"ratio", "rattan", "rattle", "raven", "ravine", "raw", "rawhide", "ray",
+	"razor", "razorbill", "reach", "react", "reactor", "read", "readable",
+	"reader", "readiness", "ready", "real", "realign", "realm", "reap",
+	"reappear", "rear", "rearview", "reason", "reassure", "rebate", "rebel",
+	"rebound", "rebuild", "recall", "receipt", "receive", "receiver",
+	"recent", "recess", "recipe", "recital", "recite", "reckon", "recliner",
+	"record", "recount", "recover", "recruit", "rectangle", "recycle",
+	"redbird", "redcoat", "redeem", "redhead", "reduce", "redwood", "reed",
+	"reedy", "reef", "reel", "refer", "refill", "refine", "refinery",
+	"reflect", "reform", "refresh", "refuge", "refund", "refuse", "regal",
+	"regard", "regatta", "regimen", "region", "regional", "register",
+	"regret", "regular", "rehearse", "reign", "reindeer", "rejoice", "relate",
+	"relax", "relaxed", "relay", "release", "relic", "relief", "relish",
+	"rely", "remain", "remake", "remark", "remedy", "remind", "reminder",
+	"remnant", "remodel", "remote", "remove", "render", "renew", "renovate",
+	"rent", "repaint", "repair", "repartee", "repeat", "replace", "replica",
+	"reply", "report", "reprint", "reptile", "request", "requiem", "rescue",
+	"research", "resemble", "reserve", "reservoir", "reside", "resident",
+	"resilient", "resin", "resist", "resolve", "resort", "resource",
+	"respect", "respite", "respond", "rest", "restful", "restore", "result",
+	"retail", "retain", "retina", "retire", "retiree", "retreat", "retrieve",
+	"return", "reunion", "reveal", "reveille", "revelry", "revenue",
+	"reverie", "review", "revise", "revival", "revive", "reward", "rhapsody",
+	"rhino", "rhubarb", "rhyme", "rhythm", "ribbon", "rice", "rich",
+	"rickshaw", "riddle", "ride", "ridge", "ridgeline", "rigging", "right",
+	"rigid", "rim", "ring", "ringlet", "ringside", "ringtone", "rinse",
+	"ripe", "ripple", "rise", "risk", "ritual", "rival", "river", "riverbank",
+	"riverbed", "riverboat", "riverside", "road", "roadmap", "roadside",
+	"roadway", "roast", "roaster", "robe", "robin", "robot", "robotic",
+	"robust", "rock", "rockery", "rocket", "rodeo", "role", "roll", "romance",
+	"roof", "rooftop", "rookie", "room", "roommate", "rooster", "root",
+	"rope", "rose", "rosebud", "rosemary", "rosette", "roster", "rotate",
+	"rotunda", "rough", "roulette", "round", "roundup", "route", "routine",
+	"rover", "row", "rowboat", "royal", "royalty", "rubber", "rubble", "ruby",
+	"rudder", "ruffle", "rug", "rugby", "ruin", "rule", "ruler", "rumba",
+	"rumble", "rumor", "runabout", "runway", "rural", "rush", "rust",
+	"rustic", "saddle", "saddlebag", "safari", "safe", "safety", "saffron",
+	"saga", "sage", "sail", "sailboat", "sailcloth", "sailfish", "sailor",
+	"saint", "salad", "salami", "salary", "sale", "salesman", "salmon",
+	"salon", "salsa", "salt", "saltwater", "salute", "salvage", "samba",
+	"same", "sample", "sampler", "sanctuary", "sand", "sandal", "sandbank",
+	"sandbar", "sandbox", "sandpaper", "sandpiper", "sandstone", "sandwich",
+	"sane", "sapling", "sapphire", "sardine", "sash", "satchel", "satellite",
+	"satin", "satire", "sauce", "saucepan", "saucer", "sauna", "sausage",
+	"savanna", "save", "savor", "savory", "savvy", "sawdust", "sawmill",
+	"saxophone", "scabbard", "scaffold", "scale", "scallop", "scan", "scarce",
+	"scarecrow", "scarf", "scatter", "scene", "scenery", "scent", "scepter",
+	"schedule", "scheme", "scholar", "school", "schooner", "science",
+	"scissors", "scoop", "scooter", "scope", "score", "scorecard", "scout",
+	"scrabble", "scrap", "scrapbook", "scrape", "scratch", "scream", "screen",
+	"screw", "scribble", "script", "scroll", "scrub", "scullery", "sculpt",
+	"sea", "seaboard", "seafarer", "seafood", "seagull", "seahorse", "seal",
+	"sealant", "seam", "seaport", "search", "seashell", "seashore", "seaside",
+	"season", "seasoning", "seat", "seaweed", "secluded", "second", "secret",
+	"secretary", "section", "sector", "secure", "sedan", "seed", "seedling",
+	"seek", "seesaw", "segment", "seize", "seldom", "select", "self",
+	"seller", "semester", "seminar", "senate", "senator", "send", "senior",
+	"sense", "sensor", "sentence", "sentinel", "sentry", "sequel", "sequence",
+	"sequin", "serenade", "serene", "sergeant", "series", "serious", "sermon",
+	"serpent", "serve", "service", "sesame", "session", "settee", "setter",
+	"settle", "settler", "setup", "seven", "seventy", "severe", "sew",
+	"sextant", "shade", "shadow", "shaft", "shake", "shallow", "shamrock",
+	"shanty", "shape", "share", "shark", "sharp", "shave", "shawl", "sheep",
+	"sheet", "shelf", "shell", "shelter", "sheriff", "shield", "shift",
+	"shimmer", "shine", "ship", "shipmate", "shipyard", "shirt", "shiver",
+	"shoe", "shoebox", "shoelace", "shopper", "shore", "shoreline", "short",
+	"shortcake", "shoulder", "shout", "shovel", "show", "showcase", "shower",
+	"showroom", "shrimp", "shrine", "shrub", "shrubbery", "shrug", "shuffle",
+	"shutter", "shuttle", "shy", "sibling", "side", "sidecar", "sideline",
+	"sidewalk", "siege", "sienna", "sieve", "sigh", "sight", "sign", "signal",
+	"signature", "signpost", "silence", "silk", "silly", "silo", "silver",
+	"similar", "simmer", "simple", "since", "sing", "singer", "single",
+	"sink", "sip", "siren", "sister", "sitcom", "site", "situate", "six",
+	"sixteen", "sixty", "size", "skate", "skeleton", "sketch", "ski", "skill",
+	"skillet", "skin", "skip", "skipper", "skirt", "skull", "sky", "skylark",
+	"skylight", "skyline", "slab", "slack", "slalom", "slate", "sled",
+	"sleek", "sleep", "sleeve", "sleigh", "slender", "slice", "slide",
+	"slight", "slim", "slingshot", "slipper", "slogan", "slope", "slot",
+	"slow", "slowpoke", "small", "smart", "smile", "smirk", "smog", "smoke",
+	"smooth", "smoothie", "snack", "snail", "snake", "snap", "snapshot",
+	"sneaker", "sniff", "snooze", "snore", "snow", "snowball", "snowdrift",
+	"snowdrop", "snowflake", "snowman", "snowplow", "snowshoe", "snowstorm",
+	"snug", "soap", "soapbox", "soccer", "social", "sock", "socket", "soda",
+	"sofa", "soft", "softball", "software", "soil", "solar", "solarium",
+	"soldier", "sole", "solid", "solo", "solstice", "solve", "sombrero",
+	"sonar", "sonata", "song", "songbird", "songbook", "sonnet", "soon",
+	"soot", "soothe", "sorbet", "sort", "soul", "sound", "soup", "source",
+	"south", "southern", "souvenir", "sow", "soybean", "space", "spaceship",
+	"spade", "spaghetti", "span", "spaniel", "spare", "spark", "sparkler",
+	"sparrow", "spatula", "speak", "spear", "special", "species", "speech",
+	"speed", "spell", "spend", "sphere", "spice", "spider", "spike", "spill",
+	"spin", "spinach", "spine", "spiral", "spirit", "splash", "split",
+	"spoke", "sponge", "spoon", "sport", "spot", "spout", "spray", "spread",
+	"spring", "sprocket", "sprout", "spruce", "spur", "spyglass", "squad",
+	"squadron", "square", "squash", "squid", "squirrel", "stable", "stack",
+	"stadium", "staff", "stage", "stair", "stake", "stall", "stallion",
+	"stamp", "stampede", "stand", "staple", "stapler", "star", "starch",
+	"stare", "starfish", "start", "state", "station", "statue", "status",
+	"stay", "steady", "steak", "steam", "steel", "steep", "steer", "stem",
+	"step", "stereo", "stetson", "stew", "stick", "still", "sting", "stir",
+	"stock", "stomach", "stone", "stool", "stop", "storage", "store", "stork",
+	"storm", "story", "stove", "straight", "strand", "strange", "strap",
+	"straw", "stream", "streamer", "street", "stretch", "strike", "string",
+	"strip", "stripe", "stroll", "stroller", "strong", "student", "studio",
+	"study", "stuff", "stump", "style", "subject", "submit", "subtle",
+	"suburb", "subway", "succeed", "such", "sudden", "suede", "sugar",
+	"suggest", "suit", "suitcase", "sulfur", "summer", "summit", "sun",
+	"sunbeam", "sunburn", "sundae", "sundial", "sunlight", "sunny", "sunrise",
+	"sunroof", "sunset", "sunshine", "super", "supper", "supply", "support",
+	"supreme", "sure", "surf", "surface", "surge", "surprise", "surround",
+	"survey", "suspect", "sustain", "swallow", "swamp", "swan", "swarm",
+	"sway", "sweater", "sweep", "sweet", "swift", "swim", "swing", "switch",
+	"sword", "sycamore", "symbol", "symphony", "symptom", "syrup", "system",
+	"table", "tableau", "tablet", "tabletop", "tack", "tackle", "taco",
+	"tactic", "tadpole", "taffeta", "taffy", "tag", "tail", "tailgate",
+	"tailor", "take", "tale", "talent", "talk", "tall", "tamale", "tame",
+	"tandem", "tangle", "tango", "tank", "tanker", "tape", "taper",
+	"tapestry", "tapioca", "tar", "target", "tariff", "tarragon", "tart",
+	"tartan", "task", "taskbar", "taste", "tasty", "tattoo", "tavern", "taxi",
+	"tea", "teach", "teacher", "teacup", "team", "teammate", "teamwork",
+	"teapot", "tear", "teardrop", "tease", "teaspoon", "tech", "teddy",
+	"teenager", "teeth", "telegram", "tell", "temper", "temple", "tempo",
+	"tenant", "tend", "tender", "tendril", "tennis", "tenor", "tent",
+	"tentacle", "term", "terrace", "terrain", "terrapin", "terrier", "test",
+	"text", "textbook", "textile", "texture", "thank", "thankful", "thaw",
+	"theater", "theme", "theory", "thermal", "thick", "thicket", "thigh",
+	"thimble", "thing", "think", "thinker", "third", "thirst", "thirteen",
+	"thirty", "thistle", "thorn", "thought", "thousand", "thread", "three",
+	"thresher", "thrill", "thriller", "throat", "throne", "throttle",
+	"through", "throw", "thrush", "thumb", "thunder", "thyme", "ticket",
+	"tide", "tidy", "tiebreak", "tiger", "tight", "tile", "till", "timber",
+	"time", "timid", "tin", "tinsel", "tiny", "tip", "tiptoe", "tire",
+	"tissue", "titanium", "title", "toad", "toast", "toaster", "toboggan",
+	"today", "toddler", "toe", "toffee", "together", "toggle", "toilet",
+	"token", "tollgate", "tomato", "tomb", "tombola", "tomorrow", "ton",
+	"tone", "tongue", "tonic", "tool", "toolbox", "tooth", "topaz", "topiary",
+	"topic", "topsoil", "torch", "tornado", "tortilla", "tortoise", "toss",
+	"total", "totem", "toucan", "touch", "tough", "tour", "tourist", "tow",
+	"toward", "towel", "tower", "town", "township", "toy", "toyshop", "trace",
+	"track", "tractor", "trade", "traffic", "trail", "trailer", "train",
+	"trainee", "trait", "tram", "trance", "transfer", "transit", "trap",
+	"trapeze", "travel", "tray", "tread", "treasure", "treat", "treaty",
+	"tree", "treetop", "trek", "trellis", "tremble", "trench", "trend",
+	"trial", "triangle", "tribe", "tribute", "trick", "tricycle", "trident",
+	"trigger", "trilogy", "trim", "trimaran", "trinket", "trio", "trip",
+	"triplet", "tripod", "triumph", "trivia", "trolley", "trombone", "troop",
+	"trophy", "tropic", "tropical", "trot", "trouble", "trough", "trousers",
+	"trout", "trowel", "truck", "true", "truffle", "trumpet", "trunk",
+	"trust", "truth", "try", "tub", "tuba", "tube", "tuck", "tuft", "tug",
+	"tugboat", "tulip", "tumble", "tumbler", "tuna", "tundra", "tune",
+	"tunic", "tunnel", "turban", "turbine", "turkey", "turn", "turnip",
+	"turnpike", "turret", "turtle", "tutor", "tuxedo", "twelve", "twenty",
+	"twice", "twig", "twilight", "twin", "twine", "twirl", "twist", "type",
+	"typhoon", "ukulele", "ultimate", "umbrella", "umpire", "unable",
+	"unaware", "unbeaten", "unbroken", "uncle", "uncommon", "uncover",
+	"under", "underdog", "undergo", "undersea", "undertow", "undo", "unearth",
+	"uneven", "unfold", "unhappy", "unicorn", "unicycle", "uniform", "union",
+	"unique", "unison", "unit", "unite", "unity", "universe", "unknown",
+	"unless", "unlock", "unpack", "unravel", "untangle", "untie", "until",
+	"unusual", "unveil", "unwind", "upbeat", "update", "upgrade", "uphill",
+	"uphold", "upkeep", "uplift", "upload", "upon", "upper", "upright",
+	"upriver", "upscale", "upset", "upstairs", "upstream", "uptown", "upward",
+	"urban", "urge", "usable", "usage", "use", "useful", "usher", "usual",
+	"utensil", "utility", "utmost", "utopia", "utter", "vacant", "vacation",
+	"vaccine", "vacuum", "vagabond", "vague", "vain", "valet", "valiant",
+	"valid", "validate", "valley", "valuable", "value", "valve", "van",
+	"vanilla", "vanish", "vantage", "vapor", "vaporize", "variable",
+	"variety", "various", "varnish", "vary", "vase", "vast", "vault",
+	"vaulted", "vector", "vehicle", "veil", "vein", "velcro", "velocity",
+	"velvet", "vendor", "veneer", "venison", "venom", "vent", "venture",
+	"venue", "veranda", "verb", "verbena", "verdict", "verge", "verify",
+	"version", "vertical", "very", "vessel", "vest", "veteran", "veto",
+	"viaduct", "vibrant", "vicar", "vicinity", "victory", "video", "view",
+	"vigilant", "vignette", "vigor", "villa", "village", "vine", "vinegar",
+	"vineyard", "vintage", "vinyl", "viola", "violet", "violin", "viper",
+	"virtue", "virtuoso", "visa", "visible", "vision", "visit", "visitor",
+	"visor", "vista", "visual", "vital", "vitamin", "vivid", "vocal", "voice",
+	"volcano", "volume", "vote", "voucher", "vowel", "voyage", "voyager",
+	"vulture", "waddle", "wafer", "waffle", "wage", "wagon", "wainscot",
+	"waist", "wait", "waiter", "waitress", "wake", "wakeful", "walk",
+	"walkway", "wall", "wallaby", "wallet", "walnut", "walrus", "waltz",
+	"wand", "wander", "wanderer", "want", "ward", "wardrobe", "warm",
+	"warmth", "warn", "warrant", "wash", "wasp", "waste", "watch", "watchman",
+	"water", "waterway", "wave", "wax", "waxwork", "way", "wayfarer",
+	"wayside", "weak", "wealth", "wear", "weasel", "weather", "weave", "web",
+	"webcam", "website", "wedding", "wedge", "weed", "week", "weekday",
+	"weekend", "weigh", "weight", "weird", "welcome", "weld", "well", "west",
+	"western", "wet", "whale", "wharf", "wheat", "wheel", "whip", "whirl",
+	"whisk", "whisker", "whiskers", "whisper", "whistle", "white", "whole",
+	"wick", "wide", "wideband", "widow", "width", "wife", "wig", "wild",
+	"wildcat", "wildlife", "will", "willow", "win", "wind", "windmill",
+	"window", "windpipe", "windsurf", "windy", "wine", "wing", "wingspan",
+	"wink", "winner", "winter", "wipe", "wire", "wireless", "wisdom", "wise",
+	"wish", "wishbone", "wisteria", "wit", "witness", "wizard", "wobble",
+	"wolf", "woman", "wombat", "wonder", "wood", "woodland", "woodpile",
+	"woodshed", "woodwind", "woodwork", "wool", "word", "work", "workbook",
+	"workday", "workload", "workmate", "workout", "workroom", "workshop",
+	"world", "worm", "worry", "worth", "wound", "wrangler", "wrap", "wreath",
+	"wreck", "wren", "wrench", "wrestle", "wrinkle", "wrist", "write",
+	"writer", "wrong", "yacht", "yachting", "yak", "yam", "yard", "yardarm",
+	"yarn", "yawn", "year", "yearbook", "yearling", "yearly", "yeast", "yell",
+	"yellow", "yelp", "yeoman", "yes", "yet", "yeti", "yew", "yield", "yodel",
+	"yoga", "yoghurt", "yogurt", "yoke", "yolk", "young", "youth", "youthful",
+	"yoyo", "yuletide", "zeal", "zealous", "zebra", "zenith", "zeppelin",
+	"zero", "zest", "zestful", "zigzag", "zinc", "zinnia", "zipcode",
+	"zipper", "zircon", "zither", "zodiac", "zone", "zoo", "zoology", "zoom",
+	"zucchini",
+}
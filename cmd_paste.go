@@ -0,0 +1,359 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Structure for our options and state.
+type pasteCommand struct {
+
+	// The service to upload to.
+	service string
+
+	// Make the paste public, or private?
+	public  bool
+	private bool
+
+	// How long the paste should last, if the service supports expiry.
+	expire string
+
+	// The language of the paste, for syntax highlighting.
+	syntax string
+
+	// The token to authenticate with.
+	token string
+
+	// The maximum time the upload may take.
+	timeout time.Duration
+}
+
+// pasteServices are the services we can upload to.
+var pasteServices = []string{"0x0.st", "dpaste", "gist"}
+
+// Arguments adds per-command args to the object.
+func (p *pasteCommand) Arguments(f *flag.FlagSet) {
+	f.StringVar(&p.service, "service", "0x0.st", "The service to upload to: "+strings.Join(pasteServices, ", "))
+	f.BoolVar(&p.public, "public", false, "Make the paste public, and listed, where the service allows")
+	f.BoolVar(&p.private, "private", false, "Make the paste private, or unlisted, which is the default")
+	f.StringVar(&p.expire, "expire", "", "How long the paste should last, such as '1h' or '7d', where the service allows")
+	f.StringVar(&p.syntax, "syntax", "", "The language of the paste, for syntax highlighting, such as 'go' or 'python'")
+	f.StringVar(&p.token, "token", "", "The token to authenticate with, for gists this defaults to $GITHUB_TOKEN")
+	f.DurationVar(&p.timeout, "timeout", 5*time.Minute, "The maximum time the upload may take, 0 for no limit")
+}
+
+// Info returns the name of this subcommand.
+func (p *pasteCommand) Info() (string, string) {
+	return "paste", `Upload text to a pastebin, and show its URL.
+
+Details:
+
+This command uploads the named file, or STDIN if no file is given, to a
+pastebin-style service, and shows the URL of the result.  The upload is
+streamed, so large files aren't read into memory first.
+
+The services supported, via '-service', are:
+
+   0x0.st   The default.  Supports '-expire', in hours.
+   dpaste   dpaste.com.  Supports '-expire', in days, and '-syntax'.
+   gist     GitHub gists.  Requires a token, via '-token' or $GITHUB_TOKEN,
+            and supports '-public', with '-syntax' setting the extension
+            of the file.
+
+Pastes are private, or unlisted, by default.  Expiry times may be given
+as durations such as '90m', '12h', or '7d', and are rounded up to the
+units the service uses.
+
+Examples:
+
+$ dmesg | sysbox paste
+$ sysbox paste -service dpaste -expire 7d -syntax go main.go
+$ sysbox paste -service gist -public -token $TOKEN build.log`
+}
+
+// stream runs the given function in the background, writing to a pipe, and
+// returns the reading end of that pipe, so that uploads are streamed.
+func (p *pasteCommand) stream(write func(w io.Writer) error) io.Reader {
+
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(write(writer))
+	}()
+	return reader
+}
+
+// jsonString copies the input to the output as the contents of a JSON
+// string, without buffering all of it.
+func (p *pasteCommand) jsonString(w io.Writer, in io.Reader) error {
+
+	buf := make([]byte, 32*1024)
+	var pending []byte
+
+	for {
+		n, err := in.Read(buf)
+		if err != nil && err != io.EOF {
+			return err
+		}
+		chunk := append(pending, buf[:n]...)
+
+		// Hold back any character split across reads, until we have
+		// the rest of it.
+		cut := len(chunk)
+		if err == nil {
+			for i := len(chunk) - 1; i >= 0 && i >= len(chunk)-utf8.UTFMax; i-- {
+				if utf8.RuneStart(chunk[i]) {
+					if !utf8.FullRune(chunk[i:]) {
+						cut = i
+					}
+					break
+				}
+			}
+		}
+
+		encoded, _ := json.Marshal(string(chunk[:cut]))
+		if _, werr := w.Write(encoded[1 : len(encoded)-1]); werr != nil {
+			return werr
+		}
+		pending = append([]byte(nil), chunk[cut:]...)
+
+		if err == io.EOF {
+			return nil
+		}
+	}
+}
+
+// filename returns the name to upload the paste as.
+func (p *pasteCommand) filename(path string) string {
+
+	name := "paste.txt"
+	if path != "-" {
+		name = filepath.Base(path)
+	}
+	if p.syntax != "" {
+		name = strings.TrimSuffix(name, filepath.Ext(name)) + "." + p.syntax
+	}
+	return name
+}
+
+// request builds the request which uploads the input.
+func (p *pasteCommand) request(in io.Reader, name string, expire time.Duration) (*http.Request, error) {
+
+	var req *http.Request
+	var err error
+
+	switch p.service {
+	case "0x0.st":
+		// The boundary is random, so we need a writer to find it.
+		boundary := multipart.NewWriter(ioutil.Discard).Boundary()
+		body := p.stream(func(w io.Writer) error {
+			form := multipart.NewWriter(w)
+			form.SetBoundary(boundary)
+			part, err := form.CreateFormFile("file", name)
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(part, in); err != nil {
+				return err
+			}
+			if expire > 0 {
+				form.WriteField("expires", fmt.Sprintf("%d", int(math.Ceil(expire.Hours()))))
+			}
+			if !p.public {
+				form.WriteField("secret", "1")
+			}
+			return form.Close()
+		})
+		req, err = http.NewRequest(http.MethodPost, "https://0x0.st", body)
+		if err == nil {
+			req.Header.Set("Content-Type", "multipart/form-data; boundary="+boundary)
+		}
+
+	case "dpaste":
+		body := p.stream(func(w io.Writer) error {
+			io.WriteString(w, "content=")
+			buf := make([]byte, 32*1024)
+			for {
+				n, err := in.Read(buf)
+				if _, werr := io.WriteString(w, url.QueryEscape(string(buf[:n]))); werr != nil {
+					return werr
+				}
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					return err
+				}
+			}
+
+			fields := url.Values{}
+			if p.syntax != "" {
+				fields.Set("syntax", p.syntax)
+			}
+			if expire > 0 {
+				fields.Set("expiry_days", fmt.Sprintf("%d", int(math.Ceil(expire.Hours()/24))))
+			}
+			if len(fields) > 0 {
+				io.WriteString(w, "&"+fields.Encode())
+			}
+			return nil
+		})
+		req, err = http.NewRequest(http.MethodPost, "https://dpaste.com/api/v2/", body)
+		if err == nil {
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			if p.token != "" {
+				req.Header.Set("Authorization", "Bearer "+p.token)
+			}
+		}
+
+	case "gist":
+		body := p.stream(func(w io.Writer) error {
+			key, _ := json.Marshal(name)
+			fmt.Fprintf(w, `{"public":%t,"files":{%s:{"content":"`, p.public, key)
+			if err := p.jsonString(w, in); err != nil {
+				return err
+			}
+			_, err := io.WriteString(w, `"}}}`)
+			return err
+		})
+		req, err = http.NewRequest(http.MethodPost, "https://api.github.com/gists", body)
+		if err == nil {
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Accept", "application/vnd.github+json")
+			req.Header.Set("Authorization", "token "+p.token)
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "sysbox")
+	return req, nil
+}
+
+// Execute is invoked if the user specifies `paste` as the subcommand.
+func (p *pasteCommand) Execute(args []string) int {
+
+	if len(args) > 1 {
+		fmt.Printf("Usage: paste [-service name] [-public|-private] [-expire duration] [-syntax language] [file]\n")
+		return 1
+	}
+	if p.public && p.private {
+		fmt.Printf("error: -public and -private cannot be used together\n")
+		return 1
+	}
+
+	known := false
+	for _, name := range pasteServices {
+		if name == p.service {
+			known = true
+		}
+	}
+	if !known {
+		fmt.Printf("error: unknown service '%s', valid services are %s\n", p.service, strings.Join(pasteServices, ", "))
+		return 1
+	}
+
+	var expire time.Duration
+	if p.expire != "" {
+		if p.service == "gist" {
+			fmt.Printf("error: gists cannot expire\n")
+			return 1
+		}
+		var err error
+		expire, err = (&timerCommand{}).parse(p.expire)
+		if err != nil || expire <= 0 {
+			fmt.Printf("error: invalid expiry time '%s'\n", p.expire)
+			return 1
+		}
+	}
+
+	if p.service == "gist" {
+		if p.token == "" {
+			p.token = os.Getenv("GITHUB_TOKEN")
+		}
+		if p.token == "" {
+			fmt.Printf("error: gists require a token, via -token or $GITHUB_TOKEN\n")
+			return 1
+		}
+	}
+
+	path := "-"
+	in := os.Stdin
+	if len(args) == 1 && args[0] != "-" {
+		path = args[0]
+		file, err := os.Open(path)
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			return 1
+		}
+		defer file.Close()
+		in = file
+	}
+
+	req, err := p.request(in, p.filename(path), expire)
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+
+	// Reuse the plumbing of http-get, for its timeout.  The body is
+	// streamed, so the upload cannot be retried.
+	hg := &httpGetCommand{timeout: p.timeout, maxRedirects: 10}
+	response, err := hg.do(req)
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+	defer response.Body.Close()
+
+	data, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+	text := strings.TrimSpace(string(data))
+
+	// Services may give JSON, with their URL or an error message.
+	var fields struct {
+		URL     string `json:"html_url"`
+		Message string `json:"message"`
+		Error   string `json:"error"`
+	}
+	isJSON := json.Unmarshal(data, &fields) == nil
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		message := strings.SplitN(text, "\n", 2)[0]
+		if isJSON && fields.Message != "" {
+			message = fields.Message
+		} else if isJSON && fields.Error != "" {
+			message = fields.Error
+		}
+		if message == "" {
+			message = response.Status
+		}
+		fmt.Printf("error: %s: %s\n", req.URL.Host, message)
+		return 1
+	}
+
+	if isJSON {
+		text = fields.URL
+	}
+	if text == "" {
+		text = response.Header.Get("Location")
+	}
+	fmt.Println(text)
+
+	return 0
+}
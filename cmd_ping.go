@@ -0,0 +1,307 @@
+package main
+
+import (
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"math"
+	"net"
+	"os"
+	"os/signal"
+	"time"
+
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+)
+
+// Structure for our options and state.
+type pingCommand struct {
+
+	// The number of requests to send, zero for no limit.
+	count int
+
+	// The number of seconds to wait between requests.
+	interval float64
+
+	// The time to wait for replies, after the last request.
+	timeout time.Duration
+
+	// Use only IPv4, or IPv6?
+	ipv4 bool
+	ipv6 bool
+}
+
+// pingReply is an echo reply we've received.
+type pingReply struct {
+
+	// The sequence number of the request.
+	seq int
+
+	// The size of the reply, in bytes.
+	size int
+
+	// The round-trip time.
+	rtt time.Duration
+}
+
+// pingSize is the size of the data we send with each request.
+const pingSize = 56
+
+// Arguments adds per-command args to the object.
+func (p *pingCommand) Arguments(f *flag.FlagSet) {
+	f.IntVar(&p.count, "c", 0, "The number of requests to send, zero to send them until interrupted")
+	f.Float64Var(&p.interval, "i", 1, "The number of seconds to wait between requests")
+	f.DurationVar(&p.timeout, "timeout", 2*time.Second, "The time to wait for replies after the last request")
+	f.BoolVar(&p.ipv4, "4", false, "Use IPv4 only")
+	f.BoolVar(&p.ipv6, "6", false, "Use IPv6 only")
+}
+
+// Info returns the name of this subcommand.
+func (p *pingCommand) Info() (string, string) {
+	return "ping", `Send ICMP echo requests to a host.
+
+Details:
+
+This command sends ICMP echo requests to the given host, every second
+or the interval given via '-i', and shows the round-trip time of each
+reply.  It runs until interrupted, or until the number of requests
+given via '-c' have been sent, then shows the packet loss and the
+minimum, average, maximum, and standard deviation of the round-trip
+times.
+
+Both IPv4 and IPv6 are supported, and '-4' or '-6' may be used to choose
+between them when a host has both.
+
+Raw sockets are used if permitted, otherwise the unprivileged ICMP
+sockets Linux provides are used, which may need to be enabled via the
+'net.ipv4.ping_group_range' sysctl.
+
+The exit-code is zero if any replies were received.
+
+Examples:
+
+$ sysbox ping -c 4 example.com
+$ sysbox ping -6 -i 0.2 ::1`
+}
+
+// listen opens an ICMP socket, returning it and whether it is a raw one.
+func (p *pingCommand) listen(v6 bool) (*icmp.PacketConn, bool, error) {
+
+	raw, udp, addr := "ip4:icmp", "udp4", "0.0.0.0"
+	if v6 {
+		raw, udp, addr = "ip6:ipv6-icmp", "udp6", "::"
+	}
+
+	conn, err := icmp.ListenPacket(raw, addr)
+	if err == nil {
+		return conn, true, nil
+	}
+
+	conn, err = icmp.ListenPacket(udp, addr)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to open an ICMP socket, raw or unprivileged: %s", err.Error())
+	}
+	return conn, false, nil
+}
+
+// receive reads replies from the socket, and sends them to the channel.
+func (p *pingCommand) receive(conn *icmp.PacketConn, raw bool, v6 bool, target net.IP, id int, replies chan<- pingReply) {
+
+	proto := 1
+	if v6 {
+		proto = 58
+	}
+
+	buf := make([]byte, 1500)
+	for {
+		n, peer, err := conn.ReadFrom(buf)
+		if err != nil {
+			close(replies)
+			return
+		}
+
+		// Raw sockets see every ICMP packet, not just our replies.
+		var from net.IP
+		switch addr := peer.(type) {
+		case *net.IPAddr:
+			from = addr.IP
+		case *net.UDPAddr:
+			from = addr.IP
+		}
+		if !from.Equal(target) {
+			continue
+		}
+
+		msg, err := icmp.ParseMessage(proto, buf[:n])
+		if err != nil || (msg.Type != ipv4.ICMPTypeEchoReply && msg.Type != ipv6.ICMPTypeEchoReply) {
+			continue
+		}
+		echo, ok := msg.Body.(*icmp.Echo)
+		if !ok || len(echo.Data) < 8 {
+			continue
+		}
+
+		// The kernel chooses the identifier of unprivileged sockets.
+		if raw && echo.ID != id {
+			continue
+		}
+
+		sent := time.Unix(0, int64(binary.BigEndian.Uint64(echo.Data)))
+		replies <- pingReply{seq: echo.Seq, size: n, rtt: time.Since(sent)}
+	}
+}
+
+// Execute is invoked if the user specifies `ping` as the subcommand.
+func (p *pingCommand) Execute(args []string) int {
+
+	if len(args) != 1 {
+		fmt.Printf("Usage: ping [-c count] [-i interval] [-4|-6] host\n")
+		return 1
+	}
+	if p.ipv4 && p.ipv6 {
+		fmt.Printf("error: -4 and -6 cannot be used together\n")
+		return 1
+	}
+	if p.interval <= 0 {
+		fmt.Printf("error: -i must be positive\n")
+		return 1
+	}
+
+	network := "ip"
+	if p.ipv4 {
+		network = "ip4"
+	}
+	if p.ipv6 {
+		network = "ip6"
+	}
+
+	target, err := net.ResolveIPAddr(network, args[0])
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+	v6 := target.IP.To4() == nil
+
+	conn, raw, err := p.listen(v6)
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+	defer conn.Close()
+
+	// Unprivileged sockets are addressed via UDP addresses.
+	var dest net.Addr = target
+	if !raw {
+		dest = &net.UDPAddr{IP: target.IP, Zone: target.Zone}
+	}
+
+	var kind icmp.Type = ipv4.ICMPTypeEcho
+	if v6 {
+		kind = ipv6.ICMPTypeEchoRequest
+	}
+
+	id := os.Getpid() & 0xffff
+	replies := make(chan pingReply, 16)
+	go p.receive(conn, raw, v6, target.IP, id, replies)
+
+	// Catch Ctrl-C, so we can show the statistics.
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	fmt.Printf("PING %s (%s): %d data bytes\n", args[0], target.String(), pingSize)
+
+	ticker := time.NewTicker(time.Duration(p.interval * float64(time.Second)))
+	defer ticker.Stop()
+
+	// The timer which ends the run, once all requests have been sent.
+	var finished <-chan time.Time
+
+	sent := 0
+	seen := make(map[int]bool)
+	var rtts []time.Duration
+
+	send := func() {
+		data := make([]byte, pingSize)
+		binary.BigEndian.PutUint64(data, uint64(time.Now().UnixNano()))
+
+		msg := icmp.Message{
+			Type: kind,
+			Body: &icmp.Echo{ID: id, Seq: sent & 0xffff, Data: data},
+		}
+		packet, err := msg.Marshal(nil)
+		if err == nil {
+			_, err = conn.WriteTo(packet, dest)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "warning: icmp_seq=%d %s\n", sent, err.Error())
+		}
+		sent++
+
+		if p.count > 0 && sent >= p.count {
+			ticker.Stop()
+			finished = time.After(p.timeout)
+		}
+	}
+
+	send()
+
+loop:
+	for {
+		select {
+		case <-ticker.C:
+			send()
+		case reply, ok := <-replies:
+			if !ok {
+				break loop
+			}
+			if seen[reply.seq] {
+				continue
+			}
+			seen[reply.seq] = true
+			rtts = append(rtts, reply.rtt)
+
+			fmt.Printf("%d bytes from %s: icmp_seq=%d time=%.3f ms\n", reply.size, target.String(), reply.seq, float64(reply.rtt)/float64(time.Millisecond))
+
+			if p.count > 0 && len(rtts) >= p.count {
+				break loop
+			}
+		case <-finished:
+			break loop
+		case <-interrupt:
+			break loop
+		}
+	}
+
+	fmt.Printf("\n--- %s ping statistics ---\n", args[0])
+	loss := 0.0
+	if sent > 0 {
+		loss = 100 * float64(sent-len(rtts)) / float64(sent)
+	}
+	fmt.Printf("%d packets transmitted, %d received, %.1f%% packet loss\n", sent, len(rtts), loss)
+
+	if len(rtts) == 0 {
+		return 1
+	}
+
+	lowest, highest, sum, squares := rtts[0], rtts[0], 0.0, 0.0
+	for _, rtt := range rtts {
+		if rtt < lowest {
+			lowest = rtt
+		}
+		if rtt > highest {
+			highest = rtt
+		}
+		ms := float64(rtt) / float64(time.Millisecond)
+		sum += ms
+		squares += ms * ms
+	}
+	avg := sum / float64(len(rtts))
+	stddev := math.Sqrt(math.Max(squares/float64(len(rtts))-avg*avg, 0))
+
+	fmt.Printf("round-trip min/avg/max/stddev = %.3f/%.3f/%.3f/%.3f ms\n",
+		float64(lowest)/float64(time.Millisecond), avg, float64(highest)/float64(time.Millisecond), stddev)
+
+	return 0
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Structure for our options and state.
+type portCheckCommand struct {
+
+	// The time to wait for each connection.
+	timeout time.Duration
+
+	// The number of ports to probe concurrently.
+	jobs int
+
+	// Fail if any port isn't open?
+	require bool
+
+	// Show only the open ports?
+	open bool
+}
+
+// Arguments adds per-command args to the object.
+func (p *portCheckCommand) Arguments(f *flag.FlagSet) {
+	f.DurationVar(&p.timeout, "timeout", 2*time.Second, "The time to wait for each connection")
+	f.IntVar(&p.jobs, "j", 32, "The number of ports to probe concurrently")
+	f.BoolVar(&p.require, "require", false, "Exit with an error if any of the ports isn't open")
+	f.BoolVar(&p.open, "open", false, "Show only the ports which are open")
+}
+
+// Info returns the name of this subcommand.
+func (p *portCheckCommand) Info() (string, string) {
+	return "port-check", `Test whether TCP ports are reachable.
+
+Details:
+
+This command attempts to connect to each of the given TCP ports upon the
+named host, and reports whether each was:
+
+   open      The connection succeeded.
+   closed    The connection was refused.
+   filtered  The connection timed out, or failed for another reason.
+
+Ports may be given as a comma-separated list, which may include ranges,
+for example '22,80,443,8000-8100'.  Ports are probed concurrently, and a
+summary of the open ports is shown at the end.
+
+By default the exit-code is zero regardless of the results, but with
+'-require' a non-zero exit-code is returned if any port isn't open.
+
+Examples:
+
+$ sysbox port-check example.com 80,443
+$ sysbox port-check -open -timeout 500ms 192.168.1.1 1-1024
+$ sysbox port-check -require db.example.com 5432 && echo "database is up"`
+}
+
+// parsePorts parses a list of ports, such as "22,80,8000-8100".
+func parsePorts(spec string) ([]int, error) {
+
+	var ports []int
+	seen := make(map[int]bool)
+
+	parse := func(s string) (int, error) {
+		port, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil || port < 1 || port > 65535 {
+			return 0, fmt.Errorf("invalid port '%s'", s)
+		}
+		return port, nil
+	}
+
+	for _, item := range strings.Split(spec, ",") {
+		if strings.TrimSpace(item) == "" {
+			continue
+		}
+
+		first, last := item, item
+		if i := strings.Index(item, "-"); i >= 0 {
+			first, last = item[:i], item[i+1:]
+		}
+
+		start, err := parse(first)
+		if err != nil {
+			return nil, err
+		}
+		end, err := parse(last)
+		if err != nil {
+			return nil, err
+		}
+		if start > end {
+			return nil, fmt.Errorf("invalid port range '%s'", item)
+		}
+
+		for port := start; port <= end; port++ {
+			if !seen[port] {
+				seen[port] = true
+				ports = append(ports, port)
+			}
+		}
+	}
+
+	if len(ports) == 0 {
+		return nil, fmt.Errorf("no ports given")
+	}
+
+	sort.Ints(ports)
+	return ports, nil
+}
+
+// probe tests a single port, returning "open", "closed", or "filtered".
+func (p *portCheckCommand) probe(host string, port int) string {
+
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort(host, strconv.Itoa(port)), p.timeout)
+	if err == nil {
+		conn.Close()
+		return "open"
+	}
+
+	if strings.Contains(err.Error(), "connection refused") {
+		return "closed"
+	}
+	return "filtered"
+}
+
+// Execute is invoked if the user specifies `port-check` as the subcommand.
+func (p *portCheckCommand) Execute(args []string) int {
+
+	if len(args) != 2 {
+		fmt.Printf("Usage: port-check host port[,port-port..]\n")
+		return 1
+	}
+
+	host := args[0]
+	ports, err := parsePorts(args[1])
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+
+	if p.jobs < 1 {
+		fmt.Printf("error: -j must be at least 1\n")
+		return 1
+	}
+
+	// Probe the ports, via a pool of workers.
+	results := make(map[int]string)
+	jobs := make(chan int)
+
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+
+	for i := 0; i < p.jobs; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for port := range jobs {
+				state := p.probe(host, port)
+
+				mutex.Lock()
+				results[port] = state
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	for _, port := range ports {
+		jobs <- port
+	}
+	close(jobs)
+	wg.Wait()
+
+	// Show the results, in order.
+	var open []string
+	for _, port := range ports {
+		state := results[port]
+		if state == "open" {
+			open = append(open, strconv.Itoa(port))
+		} else if p.open {
+			continue
+		}
+		fmt.Printf("%d/tcp %s\n", port, state)
+	}
+
+	if len(open) > 0 {
+		fmt.Printf("Open ports: %s\n", strings.Join(open, ", "))
+	} else {
+		fmt.Printf("Open ports: none\n")
+	}
+
+	if p.require && len(open) != len(ports) {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,690 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+// Structure for our options and state.
+type qrCommand struct {
+
+	// The error-correction level, one of L, M, Q, or H.
+	level string
+
+	// The PNG file to write, if any.
+	output string
+
+	// The size of each module, in pixels, when writing a PNG.
+	size int
+
+	// The width of the quiet zone, in modules.
+	quiet int
+
+	// Invert the terminal output, for light backgrounds?
+	invert bool
+}
+
+// qrCode holds the modules of a QR code.
+type qrCode struct {
+
+	// The width, and height, of the code in modules.
+	size int
+
+	// The modules, indexed by row and then column, true being dark.
+	modules [][]bool
+
+	// The modules which are part of function patterns, rather than data.
+	function [][]bool
+}
+
+// qrLevels maps the error-correction levels to their index in our
+// tables, and the bits used to identify them in the format information.
+var qrLevels = map[string]struct{ index, bits int }{
+	"L": {0, 1},
+	"M": {1, 0},
+	"Q": {2, 3},
+	"H": {3, 2},
+}
+
+// qrECCPerBlock holds the number of error-correction codewords in each
+// block, by level and version.
+var qrECCPerBlock = [4][41]int{
+	{-1, 7, 10, 15, 20, 26, 18, 20, 24, 30, 18, 20, 24, 26, 30, 22, 24, 28, 30, 28, 28, 28, 28, 30, 30, 26, 28, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+	{-1, 10, 16, 26, 18, 24, 16, 18, 22, 22, 26, 30, 22, 22, 24, 24, 28, 28, 26, 26, 26, 26, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28},
+	{-1, 13, 22, 18, 26, 18, 24, 18, 22, 20, 24, 28, 26, 24, 20, 30, 24, 28, 28, 26, 30, 28, 30, 30, 30, 30, 28, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+	{-1, 17, 28, 22, 16, 22, 28, 26, 26, 24, 28, 24, 28, 22, 24, 24, 30, 28, 28, 26, 28, 30, 24, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+}
+
+// qrBlocks holds the number of error-correction blocks, by level and
+// version.
+var qrBlocks = [4][41]int{
+	{-1, 1, 1, 1, 1, 1, 2, 2, 2, 2, 4, 4, 4, 4, 4, 6, 6, 6, 6, 7, 8, 8, 9, 9, 10, 12, 12, 12, 13, 14, 15, 16, 17, 18, 19, 19, 20, 21, 22, 24, 25},
+	{-1, 1, 1, 1, 2, 2, 4, 4, 4, 5, 5, 5, 8, 9, 9, 10, 10, 11, 13, 14, 16, 17, 17, 18, 20, 21, 23, 25, 26, 28, 29, 31, 33, 35, 37, 38, 40, 43, 45, 47, 49},
+	{-1, 1, 1, 2, 2, 4, 4, 6, 6, 8, 8, 8, 10, 12, 16, 12, 17, 16, 18, 21, 20, 23, 23, 25, 27, 29, 34, 34, 35, 38, 40, 43, 45, 48, 51, 53, 56, 59, 62, 65, 68},
+	{-1, 1, 1, 2, 4, 4, 4, 5, 6, 8, 8, 11, 11, 16, 16, 18, 16, 19, 21, 25, 25, 25, 34, 30, 32, 35, 37, 40, 42, 45, 48, 51, 54, 57, 60, 63, 66, 70, 74, 77, 81},
+}
+
+// Arguments adds per-command args to the object.
+func (q *qrCommand) Arguments(f *flag.FlagSet) {
+	f.StringVar(&q.level, "level", "M", "The error-correction level, one of L, M, Q, or H")
+	f.StringVar(&q.output, "o", "", "Write the code to the named PNG file, rather than the terminal")
+	f.IntVar(&q.size, "size", 8, "The size of each module, in pixels, when writing a PNG")
+	f.IntVar(&q.quiet, "quiet", 4, "The width of the blank border around the code, in modules")
+	f.BoolVar(&q.invert, "invert", false, "Invert the terminal output, for terminals with a light background")
+}
+
+// Info returns the name of this subcommand.
+func (q *qrCommand) Info() (string, string) {
+	return "qr", `Generate a QR code.
+
+Details:
+
+This command encodes the given text, such as a URL, as a QR code, which
+is shown in the terminal using Unicode block characters, or written to
+a PNG file via '-o'.  If no text is given it is read from STDIN.
+
+The error-correction level may be set via '-level', with L recovering
+from about 7% of the code being damaged, M 15%, Q 25%, and H 30%.  Higher
+levels result in larger codes.
+
+The '-quiet' flag sets the width of the blank border around the code,
+which scanners need, and '-size' the number of pixels used for each
+module of a PNG.
+
+Terminal output is drawn for a dark background, so light modules are
+shown as blocks, '-invert' swaps them for light backgrounds.
+
+To share WiFi details use text of the form:
+
+   WIFI:T:WPA;S:network-name;P:password;;
+
+Examples:
+
+$ sysbox qr https://example.com/
+$ sysbox qr -o code.png -size 10 -level H https://example.com/
+$ echo 'WIFI:T:WPA;S:home;P:secret;;' | sysbox qr`
+}
+
+// qrMultiply multiplies two values in GF(2^8), modulo the QR polynomial.
+func qrMultiply(x, y byte) byte {
+
+	z := 0
+	for i := 7; i >= 0; i-- {
+		z = (z << 1) ^ ((z >> 7) * 0x11D)
+		z ^= int((y>>uint(i))&1) * int(x)
+	}
+	return byte(z)
+}
+
+// qrDivisor returns the Reed-Solomon generator polynomial of the given
+// degree.
+func qrDivisor(degree int) []byte {
+
+	result := make([]byte, degree)
+	result[degree-1] = 1
+
+	root := byte(1)
+	for i := 0; i < degree; i++ {
+		for j := range result {
+			result[j] = qrMultiply(result[j], root)
+			if j+1 < len(result) {
+				result[j] ^= result[j+1]
+			}
+		}
+		root = qrMultiply(root, 0x02)
+	}
+	return result
+}
+
+// qrRemainder returns the Reed-Solomon error-correction codewords for
+// the data.
+func qrRemainder(data []byte, divisor []byte) []byte {
+
+	result := make([]byte, len(divisor))
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+		for i := range result {
+			result[i] ^= qrMultiply(divisor[i], factor)
+		}
+	}
+	return result
+}
+
+// qrRawModules returns the number of modules available for data, and
+// error-correction, in the given version.
+func qrRawModules(version int) int {
+
+	result := (16*version+128)*version + 64
+	if version >= 2 {
+		align := version/7 + 2
+		result -= (25*align-10)*align - 55
+		if version >= 7 {
+			result -= 36
+		}
+	}
+	return result
+}
+
+// qrDataCodewords returns the number of data codewords in the given
+// version, at the given level.
+func qrDataCodewords(version int, level int) int {
+	return qrRawModules(version)/8 - qrECCPerBlock[level][version]*qrBlocks[level][version]
+}
+
+// qrEncode encodes the data as a QR code, in byte mode, using the
+// smallest version which fits.
+func qrEncode(data []byte, level string) (*qrCode, error) {
+
+	ecl := qrLevels[level]
+
+	// Find the smallest version which will fit the data.
+	version := 0
+	for v := 1; v <= 40; v++ {
+		countBits := 8
+		if v >= 10 {
+			countBits = 16
+		}
+		if 4+countBits+len(data)*8 <= qrDataCodewords(v, ecl.index)*8 {
+			version = v
+			break
+		}
+	}
+	if version == 0 {
+		return nil, fmt.Errorf("%d bytes is too much data for a QR code at level %s", len(data), level)
+	}
+
+	// Build up the bits of the data: the mode, the length, and the data.
+	var bits []bool
+	appendBits := func(value int, count int) {
+		for i := count - 1; i >= 0; i-- {
+			bits = append(bits, (value>>uint(i))&1 == 1)
+		}
+	}
+	appendBits(0x4, 4)
+	if version >= 10 {
+		appendBits(len(data), 16)
+	} else {
+		appendBits(len(data), 8)
+	}
+	for _, b := range data {
+		appendBits(int(b), 8)
+	}
+
+	// Add the terminator, and padding.
+	capacity := qrDataCodewords(version, ecl.index) * 8
+	for i := 0; i < 4 && len(bits) < capacity; i++ {
+		bits = append(bits, false)
+	}
+	for len(bits)%8 != 0 {
+		bits = append(bits, false)
+	}
+	for pad := 0xEC; len(bits) < capacity; pad ^= 0xEC ^ 0x11 {
+		appendBits(pad, 8)
+	}
+
+	codewords := make([]byte, len(bits)/8)
+	for i, bit := range bits {
+		if bit {
+			codewords[i/8] |= 1 << uint(7-i%8)
+		}
+	}
+
+	code := newQRCode(version)
+	code.drawFunctionPatterns(version)
+	code.drawCodewords(qrInterleave(codewords, version, ecl.index))
+
+	// Apply the mask with the lowest penalty.
+	best, bestPenalty := 0, -1
+	for mask := 0; mask < 8; mask++ {
+		code.applyMask(mask)
+		code.drawFormat(ecl.bits, mask)
+		if penalty := code.penalty(); bestPenalty < 0 || penalty < bestPenalty {
+			best, bestPenalty = mask, penalty
+		}
+		code.applyMask(mask)
+	}
+	code.applyMask(best)
+	code.drawFormat(ecl.bits, best)
+
+	return code, nil
+}
+
+// qrInterleave splits the data into blocks, adds error-correction to
+// each, and interleaves the results.
+func qrInterleave(data []byte, version int, level int) []byte {
+
+	blocks := qrBlocks[level][version]
+	eccLen := qrECCPerBlock[level][version]
+	raw := qrRawModules(version) / 8
+	short := blocks - raw%blocks
+	shortLen := raw / blocks
+
+	divisor := qrDivisor(eccLen)
+
+	var all [][]byte
+	k := 0
+	for i := 0; i < blocks; i++ {
+		n := shortLen - eccLen
+		if i >= short {
+			n++
+		}
+		block := append([]byte{}, data[k:k+n]...)
+		k += n
+		ecc := qrRemainder(block, divisor)
+
+		// Short blocks get a placeholder, which is skipped below.
+		if i < short {
+			block = append(block, 0)
+		}
+		all = append(all, append(block, ecc...))
+	}
+
+	var result []byte
+	for i := range all[0] {
+		for j, block := range all {
+			if i != shortLen-eccLen || j >= short {
+				result = append(result, block[i])
+			}
+		}
+	}
+	return result
+}
+
+// newQRCode returns an empty code of the given version.
+func newQRCode(version int) *qrCode {
+
+	size := version*4 + 17
+	code := &qrCode{size: size}
+	code.modules = make([][]bool, size)
+	code.function = make([][]bool, size)
+	for i := 0; i < size; i++ {
+		code.modules[i] = make([]bool, size)
+		code.function[i] = make([]bool, size)
+	}
+	return code
+}
+
+// set sets the module at the given column, and row, as part of a
+// function pattern.
+func (c *qrCode) set(x int, y int, dark bool) {
+	c.modules[y][x] = dark
+	c.function[y][x] = true
+}
+
+// drawFunctionPatterns draws the finder, timing, and alignment patterns,
+// along with the version information.
+func (c *qrCode) drawFunctionPatterns(version int) {
+
+	for i := 0; i < c.size; i++ {
+		c.set(6, i, i%2 == 0)
+		c.set(i, 6, i%2 == 0)
+	}
+
+	// The finder patterns, in three corners.
+	for _, corner := range [][2]int{{3, 3}, {c.size - 4, 3}, {3, c.size - 4}} {
+		for dy := -4; dy <= 4; dy++ {
+			for dx := -4; dx <= 4; dx++ {
+				x, y := corner[0]+dx, corner[1]+dy
+				if x < 0 || x >= c.size || y < 0 || y >= c.size {
+					continue
+				}
+				dist := qrMax(qrAbs(dx), qrAbs(dy))
+				c.set(x, y, dist != 2 && dist != 4)
+			}
+		}
+	}
+
+	// The alignment patterns, which avoid the finder patterns.
+	if version > 1 {
+		count := version/7 + 2
+		step := 26
+		if version != 32 {
+			step = (version*4 + count*2 + 1) / (count*2 - 2) * 2
+		}
+		positions := make([]int, count)
+		positions[0] = 6
+		for i, pos := count-1, c.size-7; i >= 1; i, pos = i-1, pos-step {
+			positions[i] = pos
+		}
+
+		for i, y := range positions {
+			for j, x := range positions {
+				if (i == 0 && j == 0) || (i == 0 && j == count-1) || (i == count-1 && j == 0) {
+					continue
+				}
+				for dy := -2; dy <= 2; dy++ {
+					for dx := -2; dx <= 2; dx++ {
+						c.set(x+dx, y+dy, qrMax(qrAbs(dx), qrAbs(dy)) != 1)
+					}
+				}
+			}
+		}
+	}
+
+	// Reserve the format information, which is drawn later.
+	c.drawFormat(0, 0)
+
+	// The version information, for larger codes.
+	if version >= 7 {
+		rem := version
+		for i := 0; i < 12; i++ {
+			rem = (rem << 1) ^ ((rem >> 11) * 0x1F25)
+		}
+		bits := version<<12 | rem
+		for i := 0; i < 18; i++ {
+			dark := (bits>>uint(i))&1 == 1
+			a, b := c.size-11+i%3, i/3
+			c.set(a, b, dark)
+			c.set(b, a, dark)
+		}
+	}
+}
+
+// drawFormat draws both copies of the format information.
+func (c *qrCode) drawFormat(level int, mask int) {
+
+	data := level<<3 | mask
+	rem := data
+	for i := 0; i < 10; i++ {
+		rem = (rem << 1) ^ ((rem >> 9) * 0x537)
+	}
+	bits := (data<<10 | rem) ^ 0x5412
+
+	bit := func(i int) bool {
+		return (bits>>uint(i))&1 == 1
+	}
+
+	for i := 0; i <= 5; i++ {
+		c.set(8, i, bit(i))
+	}
+	c.set(8, 7, bit(6))
+	c.set(8, 8, bit(7))
+	c.set(7, 8, bit(8))
+	for i := 9; i < 15; i++ {
+		c.set(14-i, 8, bit(i))
+	}
+
+	for i := 0; i < 8; i++ {
+		c.set(c.size-1-i, 8, bit(i))
+	}
+	for i := 8; i < 15; i++ {
+		c.set(8, c.size-15+i, bit(i))
+	}
+	c.set(8, c.size-8, true)
+}
+
+// drawCodewords draws the data in the zig-zag pattern, skipping the
+// function patterns.
+func (c *qrCode) drawCodewords(data []byte) {
+
+	i := 0
+	for right := c.size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := 0; vert < c.size; vert++ {
+			for j := 0; j < 2; j++ {
+				x := right - j
+				y := vert
+				if (right+1)&2 == 0 {
+					y = c.size - 1 - vert
+				}
+				if !c.function[y][x] && i < len(data)*8 {
+					c.modules[y][x] = (data[i/8]>>uint(7-i%8))&1 == 1
+					i++
+				}
+			}
+		}
+	}
+}
+
+// applyMask inverts the data modules selected by the given mask, so
+// applying it twice undoes it.
+func (c *qrCode) applyMask(mask int) {
+
+	for y := 0; y < c.size; y++ {
+		for x := 0; x < c.size; x++ {
+			var invert bool
+			switch mask {
+			case 0:
+				invert = (x+y)%2 == 0
+			case 1:
+				invert = y%2 == 0
+			case 2:
+				invert = x%3 == 0
+			case 3:
+				invert = (x+y)%3 == 0
+			case 4:
+				invert = (x/3+y/2)%2 == 0
+			case 5:
+				invert = x*y%2+x*y%3 == 0
+			case 6:
+				invert = (x*y%2+x*y%3)%2 == 0
+			case 7:
+				invert = ((x+y)%2+x*y%3)%2 == 0
+			}
+			if invert && !c.function[y][x] {
+				c.modules[y][x] = !c.modules[y][x]
+			}
+		}
+	}
+}
+
+// penalty scores the code, with lower scores being easier to scan.
+func (c *qrCode) penalty() int {
+
+	result := 0
+
+	// Read along rows, or columns.
+	at := func(line int, i int, columns bool) bool {
+		if columns {
+			return c.modules[i][line]
+		}
+		return c.modules[line][i]
+	}
+
+	finder := []bool{true, false, true, true, true, false, true}
+
+	for _, columns := range []bool{false, true} {
+		for line := 0; line < c.size; line++ {
+
+			// Runs of five, or more, modules of the same colour.
+			run := 1
+			for i := 1; i <= c.size; i++ {
+				if i < c.size && at(line, i, columns) == at(line, i-1, columns) {
+					run++
+					continue
+				}
+				if run >= 5 {
+					result += 3 + run - 5
+				}
+				run = 1
+			}
+
+			// Patterns which look like finders, with light modules on
+			// either side.
+			for i := 0; i+len(finder) <= c.size; i++ {
+				match := true
+				for j, dark := range finder {
+					if at(line, i+j, columns) != dark {
+						match = false
+						break
+					}
+				}
+				if !match {
+					continue
+				}
+				light := func(from int, to int) bool {
+					if from < 0 || to > c.size {
+						return false
+					}
+					for k := from; k < to; k++ {
+						if at(line, k, columns) {
+							return false
+						}
+					}
+					return true
+				}
+				if light(i-4, i) || light(i+7, i+11) {
+					result += 40
+				}
+			}
+		}
+	}
+
+	// Blocks of 2x2 modules of the same colour.
+	dark := 0
+	for y := 0; y < c.size; y++ {
+		for x := 0; x < c.size; x++ {
+			if c.modules[y][x] {
+				dark++
+			}
+			if x+1 < c.size && y+1 < c.size {
+				m := c.modules[y][x]
+				if m == c.modules[y][x+1] && m == c.modules[y+1][x] && m == c.modules[y+1][x+1] {
+					result += 3
+				}
+			}
+		}
+	}
+
+	// The balance of dark and light modules.
+	total := c.size * c.size
+	result += qrAbs(dark*20-total*10) / total * 10
+
+	return result
+}
+
+// dark returns true if the module is dark, allowing for the quiet zone.
+func (c *qrCode) dark(x int, y int) bool {
+	if x < 0 || y < 0 || x >= c.size || y >= c.size {
+		return false
+	}
+	return c.modules[y][x]
+}
+
+// qrAbs returns the absolute value of an integer.
+func qrAbs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// qrMax returns the larger of two integers.
+func qrMax(a int, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// writePNG writes the code to the named file.
+func (q *qrCommand) writePNG(code *qrCode) error {
+
+	width := (code.size + q.quiet*2) * q.size
+	img := image.NewGray(image.Rect(0, 0, width, width))
+
+	for py := 0; py < width; py++ {
+		for px := 0; px < width; px++ {
+			shade := color.Gray{Y: 255}
+			if code.dark(px/q.size-q.quiet, py/q.size-q.quiet) {
+				shade = color.Gray{Y: 0}
+			}
+			img.SetGray(px, py, shade)
+		}
+	}
+
+	file, err := os.Create(q.output)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(file, img); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// show outputs the code to the terminal, using half-blocks so that each
+// line of text shows two rows of modules.
+func (q *qrCommand) show(code *qrCode) {
+
+	// Light modules are drawn, unless inverted.
+	drawn := func(x int, y int) bool {
+		return code.dark(x, y) == q.invert
+	}
+
+	var out strings.Builder
+	for y := -q.quiet; y < code.size+q.quiet; y += 2 {
+		for x := -q.quiet; x < code.size+q.quiet; x++ {
+			top := drawn(x, y)
+			bottom := drawn(x, y+1) && y+1 < code.size+q.quiet
+			switch {
+			case top && bottom:
+				out.WriteString("█")
+			case top:
+				out.WriteString("▀")
+			case bottom:
+				out.WriteString("▄")
+			default:
+				out.WriteString(" ")
+			}
+		}
+		out.WriteString("\n")
+	}
+	fmt.Print(out.String())
+}
+
+// Execute is invoked if the user specifies `qr` as the subcommand.
+func (q *qrCommand) Execute(args []string) int {
+
+	q.level = strings.ToUpper(q.level)
+	if _, ok := qrLevels[q.level]; !ok {
+		fmt.Printf("error: -level must be one of L, M, Q, or H\n")
+		return 1
+	}
+	if q.size < 1 {
+		fmt.Printf("error: -size must be at least 1\n")
+		return 1
+	}
+	if q.quiet < 0 {
+		fmt.Printf("error: -quiet must not be negative\n")
+		return 1
+	}
+
+	text := strings.Join(args, " ")
+	if len(args) == 0 {
+		data, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			fmt.Printf("error reading STDIN: %s\n", err.Error())
+			return 1
+		}
+		text = strings.TrimRight(string(data), "\r\n")
+	}
+
+	code, err := qrEncode([]byte(text), q.level)
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+
+	if q.output != "" {
+		if err := q.writePNG(code); err != nil {
+			fmt.Printf("error writing %s: %s\n", q.output, err.Error())
+			return 1
+		}
+		return 0
+	}
+
+	q.show(code)
+	return 0
+}
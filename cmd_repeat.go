@@ -0,0 +1,107 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+)
+
+// Structure for our options and state.
+type repeatCommand struct {
+
+	// The number of times to repeat the string.
+	count int
+
+	// The separator between repetitions.
+	separator string
+
+	// Show each repetition on its own line?
+	newline bool
+
+	// The width to fill, rather than repeating a number of times.
+	width int
+}
+
+// Arguments adds per-command args to the object.
+func (r *repeatCommand) Arguments(f *flag.FlagSet) {
+	f.IntVar(&r.count, "n", 1, "The number of times to repeat the string")
+	f.StringVar(&r.separator, "s", "", "The separator to output between repetitions")
+	f.BoolVar(&r.newline, "newline", false, "Output each repetition on its own line")
+	f.IntVar(&r.width, "w", 0, "Repeat the string to fill the given width, rather than a number of times")
+}
+
+// Info returns the name of this subcommand.
+func (r *repeatCommand) Info() (string, string) {
+	return "repeat", `Output a string repeatedly.
+
+Details:
+
+This command outputs the given string the number of times specified via
+'-n', separated by the separator given via '-s', followed by a newline.
+If the count is zero nothing is output.
+
+The '-newline' flag outputs each repetition on its own line instead.
+
+The '-w' flag repeats the string as many times as needed to fill the
+given width, with the last repetition being cut short if needed, which
+is useful for drawing horizontal rules.  Widths are measured in
+characters, rather than bytes.
+
+Examples:
+
+$ sysbox repeat -n 3 -s ', ' hello
+$ sysbox repeat -w 80 =
+$ sysbox repeat -n 5 -newline 'SELECT 1;'`
+}
+
+// Execute is invoked if the user specifies `repeat` as the subcommand.
+func (r *repeatCommand) Execute(args []string) int {
+
+	if len(args) != 1 {
+		fmt.Printf("Usage: repeat [-n count] [-s separator] [-newline] [-w width] string\n")
+		return 1
+	}
+	if r.count < 0 {
+		fmt.Printf("error: -n must not be negative\n")
+		return 1
+	}
+	if r.width < 0 {
+		fmt.Printf("error: -w must not be negative\n")
+		return 1
+	}
+
+	text := args[0]
+	separator := r.separator
+	if r.newline {
+		separator = "\n"
+	}
+
+	if r.width > 0 {
+		if text == "" {
+			fmt.Printf("error: the string must not be empty when using -w\n")
+			return 1
+		}
+
+		var out []rune
+		for len(out) < r.width {
+			if len(out) > 0 {
+				out = append(out, []rune(separator)...)
+			}
+			out = append(out, []rune(text)...)
+		}
+		fmt.Println(string(out[:r.width]))
+		return 0
+	}
+
+	if r.count == 0 {
+		return 0
+	}
+
+	parts := make([]string, r.count)
+	for i := range parts {
+		parts[i] = text
+	}
+	fmt.Println(strings.Join(parts, separator))
+
+	return 0
+}
@@ -0,0 +1,346 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Structure for our options and state.
+type rollCommand struct {
+
+	// The number of times to roll.
+	count int
+}
+
+// rollToken is a single token of a dice expression.
+type rollToken struct {
+
+	// The text of the token, e.g. "d", "kh", "+", or a number.
+	text string
+
+	// The position of the token within the expression.
+	pos int
+}
+
+// rollParser parses, and evaluates, a dice expression.
+type rollParser struct {
+
+	// The tokens of the expression.
+	tokens []rollToken
+
+	// The current position within the tokens.
+	pos int
+
+	// The length of the expression, for reporting errors at the end.
+	length int
+
+	// The expression, with the dice replaced by their rolls.
+	shown strings.Builder
+}
+
+// Maximum limits on dice, to avoid runaway rolls.
+const (
+	rollMaxDice  = 1000
+	rollMaxSides = 1000000
+)
+
+// Arguments adds per-command args to the object.
+func (r *rollCommand) Arguments(f *flag.FlagSet) {
+	f.IntVar(&r.count, "n", 1, "The number of times to roll")
+}
+
+// Info returns the name of this subcommand.
+func (r *rollCommand) Info() (string, string) {
+	return "roll", `Roll dice.
+
+Details:
+
+This command evaluates expressions in dice notation, showing the value
+of each die, and the total.  For example '3d6+2' rolls three six-sided
+dice, and adds two to the result.
+
+Dice are written as 'NdS', for N dice with S sides, where N defaults to
+one, and 'd%' is a percentile die with 100 sides.  Adding 'khK' keeps
+only the highest K dice, and 'klK' only the lowest, so '4d6kh3' rolls
+four dice and totals the highest three.  Dice which are dropped are
+shown in parentheses.
+
+Dice, and numbers, may be combined with +, -, *, /, and parentheses,
+with division rounding towards zero.
+
+All randomness comes from the operating system's secure random number
+generator.
+
+Examples:
+
+$ sysbox roll 1d20
+$ sysbox roll 3d6+2
+$ sysbox roll -n 6 4d6kh3
+$ sysbox roll '2d10*5' d%`
+}
+
+// tokenize splits the expression into tokens.
+func (p *rollParser) tokenize(expr string) error {
+
+	p.length = len(expr)
+
+	for i := 0; i < len(expr); {
+		c := expr[i]
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+		case c >= '0' && c <= '9':
+			start := i
+			for i < len(expr) && expr[i] >= '0' && expr[i] <= '9' {
+				i++
+			}
+			p.tokens = append(p.tokens, rollToken{text: expr[start:i], pos: start})
+		case c == 'k' || c == 'K':
+			if i+1 >= len(expr) || (expr[i+1]|0x20 != 'h' && expr[i+1]|0x20 != 'l') {
+				return fmt.Errorf("syntax error at position %d: expected 'kh' or 'kl'", i+1)
+			}
+			p.tokens = append(p.tokens, rollToken{text: strings.ToLower(expr[i : i+2]), pos: i})
+			i += 2
+		case c == 'd' || c == 'D':
+			p.tokens = append(p.tokens, rollToken{text: "d", pos: i})
+			i++
+		case strings.IndexByte("%+-*/()", c) >= 0:
+			p.tokens = append(p.tokens, rollToken{text: string(c), pos: i})
+			i++
+		default:
+			return fmt.Errorf("syntax error at position %d: unexpected '%c'", i+1, c)
+		}
+	}
+	return nil
+}
+
+// peek returns the text of the current token, or "" at the end.
+func (p *rollParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos].text
+	}
+	return ""
+}
+
+// fail returns an error describing what we expected at the current token.
+func (p *rollParser) fail(expected string) error {
+	if p.pos < len(p.tokens) {
+		t := p.tokens[p.pos]
+		return fmt.Errorf("syntax error at position %d: expected %s, found '%s'", t.pos+1, expected, t.text)
+	}
+	return fmt.Errorf("syntax error at position %d: expected %s, found the end of the expression", p.length+1, expected)
+}
+
+// number parses a number token.
+func (p *rollParser) number() (int, bool) {
+	n, err := strconv.Atoi(p.peek())
+	if err != nil {
+		return 0, false
+	}
+	p.pos++
+	return n, true
+}
+
+// expression parses, and evaluates, terms separated by + or -.
+func (p *rollParser) expression() (int, error) {
+
+	total, err := p.term()
+	if err != nil {
+		return 0, err
+	}
+
+	for p.peek() == "+" || p.peek() == "-" {
+		op := p.peek()
+		p.pos++
+		p.shown.WriteString(" " + op + " ")
+
+		value, err := p.term()
+		if err != nil {
+			return 0, err
+		}
+		if op == "+" {
+			total += value
+		} else {
+			total -= value
+		}
+	}
+	return total, nil
+}
+
+// term parses, and evaluates, factors separated by * or /.
+func (p *rollParser) term() (int, error) {
+
+	total, err := p.factor()
+	if err != nil {
+		return 0, err
+	}
+
+	for p.peek() == "*" || p.peek() == "/" {
+		op := p.peek()
+		p.pos++
+		p.shown.WriteString(" " + op + " ")
+
+		value, err := p.factor()
+		if err != nil {
+			return 0, err
+		}
+		if op == "*" {
+			total *= value
+		} else {
+			if value == 0 {
+				return 0, fmt.Errorf("division by zero")
+			}
+			total /= value
+		}
+	}
+	return total, nil
+}
+
+// factor parses, and evaluates, a number, a roll, or a parenthesized
+// expression.
+func (p *rollParser) factor() (int, error) {
+
+	if p.peek() == "(" {
+		p.pos++
+		p.shown.WriteString("(")
+		value, err := p.expression()
+		if err != nil {
+			return 0, err
+		}
+		if p.peek() != ")" {
+			return 0, p.fail("')'")
+		}
+		p.pos++
+		p.shown.WriteString(")")
+		return value, nil
+	}
+
+	count, ok := p.number()
+	if p.peek() != "d" {
+		if !ok {
+			return 0, p.fail("a number or dice")
+		}
+		p.shown.WriteString(strconv.Itoa(count))
+		return count, nil
+	}
+	if !ok {
+		count = 1
+	}
+	p.pos++
+
+	sides := 100
+	if p.peek() == "%" {
+		p.pos++
+	} else if sides, ok = p.number(); !ok {
+		return 0, p.fail("the number of sides")
+	}
+
+	keep := count
+	op := p.peek()
+	if op == "kh" || op == "kl" {
+		p.pos++
+		if keep, ok = p.number(); !ok {
+			return 0, p.fail("the number of dice to keep")
+		}
+	}
+
+	if count < 1 || count > rollMaxDice {
+		return 0, fmt.Errorf("the number of dice must be between 1 and %d", rollMaxDice)
+	}
+	if sides < 1 || sides > rollMaxSides {
+		return 0, fmt.Errorf("the number of sides must be between 1 and %d", rollMaxSides)
+	}
+	if keep < 1 || keep > count {
+		return 0, fmt.Errorf("the number of dice to keep must be between 1 and %d", count)
+	}
+
+	return p.roll(count, sides, keep, op == "kl"), nil
+}
+
+// roll rolls the given dice, keeping the highest, or lowest, and returns
+// the total.
+func (p *rollParser) roll(count int, sides int, keep int, lowest bool) int {
+
+	values := make([]int, count)
+	for i := range values {
+		values[i] = randomInt(sides) + 1
+	}
+
+	// Work out which dice are kept.
+	order := make([]int, count)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		if lowest {
+			return values[order[i]] < values[order[j]]
+		}
+		return values[order[i]] > values[order[j]]
+	})
+	kept := make(map[int]bool)
+	for _, i := range order[:keep] {
+		kept[i] = true
+	}
+
+	total := 0
+	var shown []string
+	for i, value := range values {
+		if kept[i] {
+			total += value
+			shown = append(shown, strconv.Itoa(value))
+		} else {
+			shown = append(shown, "("+strconv.Itoa(value)+")")
+		}
+	}
+	p.shown.WriteString("[" + strings.Join(shown, " ") + "]")
+
+	return total
+}
+
+// evaluate parses, and rolls, the given expression, returning the total
+// along with the expression showing the individual dice.
+func (r *rollCommand) evaluate(expr string) (int, string, error) {
+
+	p := &rollParser{}
+	if err := p.tokenize(expr); err != nil {
+		return 0, "", err
+	}
+
+	total, err := p.expression()
+	if err != nil {
+		return 0, "", err
+	}
+	if p.pos < len(p.tokens) {
+		return 0, "", p.fail("an operator")
+	}
+	return total, p.shown.String(), nil
+}
+
+// Execute is invoked if the user specifies `roll` as the subcommand.
+func (r *rollCommand) Execute(args []string) int {
+
+	if len(args) < 1 {
+		fmt.Printf("Usage: roll [-n count] expression [expression ...]\n")
+		return 1
+	}
+	if r.count < 1 {
+		fmt.Printf("error: -n must be at least 1\n")
+		return 1
+	}
+
+	for _, expr := range args {
+		for i := 0; i < r.count; i++ {
+			total, shown, err := r.evaluate(expr)
+			if err != nil {
+				fmt.Printf("error: %s: %s\n", expr, err.Error())
+				return 1
+			}
+			fmt.Printf("%s: %s = %d\n", expr, shown, total)
+		}
+	}
+
+	return 0
+}
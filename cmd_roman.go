@@ -0,0 +1,158 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Structure for our options and state.
+type romanCommand struct {
+
+	// Convert from Roman numerals to integers?
+	decode bool
+
+	// Reject numerals which aren't in the standard form?
+	strict bool
+}
+
+// romanNumerals are the symbols used, in descending order of value,
+// including the subtractive pairs.
+var romanNumerals = []struct {
+	value  int
+	symbol string
+}{
+	{1000, "M"}, {900, "CM"}, {500, "D"}, {400, "CD"},
+	{100, "C"}, {90, "XC"}, {50, "L"}, {40, "XL"},
+	{10, "X"}, {9, "IX"}, {5, "V"}, {4, "IV"},
+	{1, "I"},
+}
+
+// Arguments adds per-command args to the object.
+func (r *romanCommand) Arguments(f *flag.FlagSet) {
+	f.BoolVar(&r.decode, "d", false, "Convert Roman numerals to integers")
+	f.BoolVar(&r.strict, "strict", false, "Reject Roman numerals which aren't in the standard form, such as 'IIII'")
+}
+
+// Info returns the name of this subcommand.
+func (r *romanCommand) Info() (string, string) {
+	return "roman", `Convert between integers and Roman numerals.
+
+Details:
+
+This command converts integers to Roman numerals, or with '-d' converts
+Roman numerals to integers.  Only values between 1 and 3999 may be
+represented in the standard form.
+
+When decoding, numerals are accepted in either case, and non-standard
+forms such as 'IIII' or 'IC' are accepted too.  The '-strict' flag
+rejects anything which isn't in the standard form.
+
+Examples:
+
+$ sysbox roman 2024
+MMXXIV
+$ sysbox roman -d MCMXCIX
+1999
+$ sysbox roman -d -strict IIII
+error: 'IIII' is not a valid Roman numeral, did you mean 'IV'?`
+}
+
+// encode returns the Roman numeral for the given value, which must be
+// in the range 1-3999.
+func (r *romanCommand) encode(value int) (string, error) {
+
+	if value < 1 || value > 3999 {
+		return "", fmt.Errorf("%d is outside the range 1-3999", value)
+	}
+
+	var out strings.Builder
+	for _, n := range romanNumerals {
+		for value >= n.value {
+			out.WriteString(n.symbol)
+			value -= n.value
+		}
+	}
+	return out.String(), nil
+}
+
+// parse returns the value of the given Roman numeral.
+func (r *romanCommand) parse(numeral string) (int, error) {
+
+	values := map[rune]int{
+		'I': 1, 'V': 5, 'X': 10, 'L': 50, 'C': 100, 'D': 500, 'M': 1000,
+	}
+
+	upper := strings.ToUpper(numeral)
+	if upper == "" {
+		return 0, fmt.Errorf("empty Roman numeral")
+	}
+
+	// Symbols are added, unless followed by a larger one.
+	total := 0
+	runes := []rune(upper)
+	for i, c := range runes {
+		value, ok := values[c]
+		if !ok {
+			return 0, fmt.Errorf("'%s' is not a valid Roman numeral", numeral)
+		}
+		if i+1 < len(runes) && values[runes[i+1]] > value {
+			total -= value
+		} else {
+			total += value
+		}
+	}
+
+	if total < 1 {
+		return 0, fmt.Errorf("'%s' is not a valid Roman numeral", numeral)
+	}
+
+	// The standard form is the one we'd generate ourselves.
+	if r.strict {
+		if total > 3999 {
+			return 0, fmt.Errorf("'%s' is larger than 3999", numeral)
+		}
+		if canonical, _ := r.encode(total); canonical != upper {
+			return 0, fmt.Errorf("'%s' is not a valid Roman numeral, did you mean '%s'?", numeral, canonical)
+		}
+	}
+
+	return total, nil
+}
+
+// Execute is invoked if the user specifies `roman` as the subcommand.
+func (r *romanCommand) Execute(args []string) int {
+
+	if len(args) < 1 {
+		fmt.Printf("Usage: roman [-d] [-strict] value [value ...]\n")
+		return 1
+	}
+
+	for _, arg := range args {
+
+		if r.decode {
+			value, err := r.parse(arg)
+			if err != nil {
+				fmt.Printf("error: %s\n", err.Error())
+				return 1
+			}
+			fmt.Println(value)
+			continue
+		}
+
+		value, err := strconv.Atoi(arg)
+		if err != nil {
+			fmt.Printf("error: '%s' is not an integer\n", arg)
+			return 1
+		}
+		numeral, err := r.encode(value)
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			return 1
+		}
+		fmt.Println(numeral)
+	}
+
+	return 0
+}
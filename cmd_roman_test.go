@@ -0,0 +1,84 @@
+package main
+
+import (
+	"testing"
+)
+
+// TestRoman tests converting values in both directions.
+func TestRoman(t *testing.T) {
+
+	tests := []struct {
+		value   int
+		numeral string
+	}{
+		{1, "I"},
+		{4, "IV"},
+		{9, "IX"},
+		{14, "XIV"},
+		{40, "XL"},
+		{90, "XC"},
+		{400, "CD"},
+		{1994, "MCMXCIV"},
+		{3999, "MMMCMXCIX"},
+	}
+
+	r := &romanCommand{strict: true}
+
+	for _, test := range tests {
+		out, err := r.encode(test.value)
+		if err != nil {
+			t.Fatalf("unexpected error encoding %d: %s", test.value, err)
+		}
+		if out != test.numeral {
+			t.Fatalf("%d gave '%s', expected '%s'", test.value, out, test.numeral)
+		}
+
+		value, err := r.parse(test.numeral)
+		if err != nil {
+			t.Fatalf("unexpected error parsing '%s': %s", test.numeral, err)
+		}
+		if value != test.value {
+			t.Fatalf("'%s' gave %d, expected %d", test.numeral, value, test.value)
+		}
+	}
+}
+
+// TestRomanInvalid tests that invalid numerals are rejected in strict mode.
+func TestRomanInvalid(t *testing.T) {
+
+	tests := []struct {
+		numeral string
+		err     string
+	}{
+		{"IIII", "'IIII' is not a valid Roman numeral, did you mean 'IV'?"},
+		{"VX", "'VX' is not a valid Roman numeral, did you mean 'V'?"},
+		{"IC", "'IC' is not a valid Roman numeral, did you mean 'XCIX'?"},
+		{"MMMM", "'MMMM' is larger than 3999"},
+		{"ABC", "'ABC' is not a valid Roman numeral"},
+		{"", "empty Roman numeral"},
+	}
+
+	r := &romanCommand{strict: true}
+
+	for _, test := range tests {
+		_, err := r.parse(test.numeral)
+		if err == nil {
+			t.Fatalf("expected an error parsing '%s'", test.numeral)
+		}
+		if err.Error() != test.err {
+			t.Fatalf("'%s' gave error '%s', expected '%s'", test.numeral, err, test.err)
+		}
+	}
+}
+
+// TestRomanRange tests that values outside the standard range are rejected.
+func TestRomanRange(t *testing.T) {
+
+	r := &romanCommand{}
+
+	for _, value := range []int{-1, 0, 4000} {
+		if _, err := r.encode(value); err == nil {
+			t.Fatalf("expected an error encoding %d", value)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Structure for our options and state.
+type rot13Command struct {
+
+	// The number of places to shift letters.
+	shift int
+
+	// Shift letters backwards, to decode?
+	decode bool
+}
+
+// Arguments adds per-command args to the object.
+func (r *rot13Command) Arguments(f *flag.FlagSet) {
+	f.IntVar(&r.shift, "n", 13, "The number of places to shift letters")
+	f.BoolVar(&r.decode, "d", false, "Decode, shifting letters backwards")
+}
+
+// Info returns the name of this subcommand.
+func (r *rot13Command) Info() (string, string) {
+	return "rot13", `Apply ROT13, or another Caesar cipher, to text.
+
+Details:
+
+This command shifts each ASCII letter in the given text thirteen places
+through the alphabet, wrapping around from 'z' to 'a', which is useful
+for hiding spoilers.  The text is read from STDIN if no arguments are
+given.  Digits, punctuation, and non-ASCII characters are unchanged.
+
+As there are twenty-six letters applying ROT13 twice gives the original
+text.  Other shifts may be used via '-n', and '-d' decodes text which
+was shifted by them.
+
+Examples:
+
+$ sysbox rot13 'Snape kills Dumbledore'
+$ sysbox rot13 -n 3 attack at dawn
+$ echo dwwdfn dw gdzq | sysbox rot13 -n 3 -d`
+}
+
+// rotate shifts the byte, if it is an ASCII letter.
+func (r *rot13Command) rotate(b byte, shift int) byte {
+
+	switch {
+	case b >= 'a' && b <= 'z':
+		return 'a' + byte((int(b-'a')+shift)%26)
+	case b >= 'A' && b <= 'Z':
+		return 'A' + byte((int(b-'A')+shift)%26)
+	}
+	return b
+}
+
+// Execute is invoked if the user specifies `rot13` as the subcommand.
+func (r *rot13Command) Execute(args []string) int {
+
+	// Normalize the shift to be forwards, and within the alphabet.
+	shift := r.shift % 26
+	if r.decode {
+		shift = -shift
+	}
+	if shift < 0 {
+		shift += 26
+	}
+
+	var in io.Reader = os.Stdin
+	if len(args) > 0 {
+		in = strings.NewReader(strings.Join(args, " ") + "\n")
+	}
+
+	// Multi-byte runes never contain ASCII bytes, so it is safe to work
+	// byte by byte.
+	reader := bufio.NewReader(in)
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+
+	for {
+		b, err := reader.ReadByte()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			out.Flush()
+			fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+			return 1
+		}
+		out.WriteByte(r.rotate(b, shift))
+	}
+
+	return 0
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Structure for our options and state.
+type seqCommand struct {
+
+	// The separator between numbers.
+	separator string
+
+	// Pad the numbers to an equal width?
+	width bool
+
+	// The printf-style format for each number.
+	format string
+}
+
+// seqFormat matches a single floating-point printf directive.
+var seqFormat = regexp.MustCompile(`%[-+ #0]*[0-9]*(\.[0-9]+)?[eEfgG]`)
+
+// Arguments adds per-command args to the object.
+func (s *seqCommand) Arguments(f *flag.FlagSet) {
+	f.StringVar(&s.separator, "s", "\n", "The separator to output between numbers")
+	f.BoolVar(&s.width, "w", false, "Pad the numbers with leading zeros, to an equal width")
+	f.StringVar(&s.format, "f", "", "The printf-style format to use for each number, e.g. '%.2f'")
+}
+
+// Info returns the name of this subcommand.
+func (s *seqCommand) Info() (string, string) {
+	return "seq", `Output a sequence of numbers.
+
+Details:
+
+This command outputs a sequence of numbers, in the same way as the
+coreutils 'seq' tool.  It may be invoked in three ways:
+
+   seq LAST              Count from 1 to LAST.
+   seq FIRST LAST        Count from FIRST to LAST.
+   seq FIRST STEP LAST   Count from FIRST to LAST, in steps of STEP.
+
+Numbers may be floating-point, and the step may be negative to count
+downwards.  By default the numbers are shown with as many decimal
+places as FIRST and STEP have.
+
+The '-s' flag changes the separator between numbers, '-w' pads them
+with leading zeros to an equal width, and '-f' gives a printf-style
+format, using one of %e, %f, or %g.
+
+Examples:
+
+$ sysbox seq 10
+$ sysbox seq -w 1 100
+$ sysbox seq 1 0.5 3
+$ sysbox seq -s , 10 -2 0
+$ sysbox seq -f 'file-%03g.txt' 5`
+}
+
+// decimals returns the number of decimal places in the given number.
+func (s *seqCommand) decimals(number string) int {
+
+	if strings.ContainsAny(number, "eE") {
+		return 0
+	}
+	if i := strings.Index(number, "."); i >= 0 {
+		return len(number) - i - 1
+	}
+	return 0
+}
+
+// pad returns the number zero-padded to the given width.
+func (s *seqCommand) pad(number string, width int) string {
+
+	if len(number) >= width {
+		return number
+	}
+	if strings.HasPrefix(number, "-") {
+		return "-" + strings.Repeat("0", width-len(number)) + number[1:]
+	}
+	return strings.Repeat("0", width-len(number)) + number
+}
+
+// Execute is invoked if the user specifies `seq` as the subcommand.
+func (s *seqCommand) Execute(args []string) int {
+
+	if len(args) < 1 || len(args) > 3 {
+		fmt.Printf("Usage: seq [-s sep] [-w] [-f format] [FIRST [STEP]] LAST\n")
+		return 1
+	}
+
+	// Fill in the defaults.
+	numbers := []string{"1", "1", args[len(args)-1]}
+	switch len(args) {
+	case 2:
+		numbers[0] = args[0]
+	case 3:
+		numbers[0] = args[0]
+		numbers[1] = args[1]
+	}
+
+	var values []float64
+	for _, number := range numbers {
+		value, err := strconv.ParseFloat(number, 64)
+		if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+			fmt.Printf("error: invalid number '%s'\n", number)
+			return 1
+		}
+		values = append(values, value)
+	}
+	first, step, last := values[0], values[1], values[2]
+
+	if step == 0 {
+		fmt.Printf("error: the step must not be zero\n")
+		return 1
+	}
+
+	if s.format != "" {
+		if s.width {
+			fmt.Printf("error: -f and -w may not be used together\n")
+			return 1
+		}
+		if len(seqFormat.FindAllString(strings.Replace(s.format, "%%", "", -1), -1)) != 1 ||
+			strings.Count(strings.Replace(s.format, "%%", "", -1), "%") != 1 {
+			fmt.Printf("error: the format must contain exactly one of %%e, %%f, or %%g\n")
+			return 1
+		}
+	}
+
+	// Show as many decimal places as the first number, and step, have.
+	precision := s.decimals(numbers[0])
+	if p := s.decimals(numbers[1]); p > precision {
+		precision = p
+	}
+
+	show := func(value float64) string {
+		if s.format != "" {
+			return fmt.Sprintf(s.format, value)
+		}
+		return strconv.FormatFloat(value, 'f', precision, 64)
+	}
+
+	// Calculate the number of steps up front, allowing for rounding,
+	// rather than accumulating errors by repeated addition.
+	count := math.Floor((last-first)/step + 1e-9)
+	if count < 0 {
+		return 0
+	}
+
+	width := 0
+	if s.width {
+		width = len(show(first))
+		if l := len(show(first + count*step)); l > width {
+			width = l
+		}
+	}
+
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+
+	for i := float64(0); i <= count; i++ {
+		if i > 0 {
+			out.WriteString(s.separator)
+		}
+		out.WriteString(s.pad(show(first+i*step), width))
+	}
+	out.WriteString("\n")
+
+	return 0
+}
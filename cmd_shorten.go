@@ -0,0 +1,233 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Structure for our options and state.
+type shortenCommand struct {
+
+	// The name of the service to use, or the URL of its API.
+	service string
+
+	// Expand a short URL, rather than creating one?
+	expand bool
+
+	// The maximum time each request may take.
+	timeout time.Duration
+}
+
+// shortenService describes the API of a URL-shortening service.
+type shortenService struct {
+
+	// The endpoint the URL is POSTed to, as the form field "url".
+	endpoint string
+
+	// Extra form fields to send, as "name=value".
+	fields []string
+
+	// The JSON keys holding the short URL, and any error message, or
+	// empty if the service responds with plain text.
+	result  string
+	failure string
+}
+
+// shortenServices are the services we know about, by name.
+var shortenServices = map[string]shortenService{
+	"cleanuri": {endpoint: "https://cleanuri.com/api/v1/shorten", result: "result_url", failure: "error"},
+	"is.gd":    {endpoint: "https://is.gd/create.php", fields: []string{"format=json"}, result: "shorturl", failure: "errormessage"},
+	"tinyurl":  {endpoint: "https://tinyurl.com/api-create.php"},
+	"v.gd":     {endpoint: "https://v.gd/create.php", fields: []string{"format=json"}, result: "shorturl", failure: "errormessage"},
+}
+
+// Arguments adds per-command args to the object.
+func (s *shortenCommand) Arguments(f *flag.FlagSet) {
+	f.StringVar(&s.service, "service", "is.gd", "The service to use, or the URL of its API")
+	f.BoolVar(&s.expand, "expand", false, "Expand a short URL, by following its redirects, rather than creating one")
+	f.DurationVar(&s.timeout, "timeout", 30*time.Second, "The maximum time each request may take")
+}
+
+// Info returns the name of this subcommand.
+func (s *shortenCommand) Info() (string, string) {
+
+	var names []string
+	for name := range shortenServices {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return "shorten", `Shorten a URL, or expand a short one.
+
+Details:
+
+This command sends the given URL to a URL-shortening service, and shows
+the short URL it returns.  The service may be chosen via '-service',
+the services we know about are:
+
+   ` + strings.Join(names, ", ") + `
+
+Other services may be used by giving the URL of their API instead, the
+long URL is POSTed to it as the form field 'url', and the response is
+expected to be the short URL, as plain text.
+
+The '-expand' flag does the reverse, following the redirects of a short
+URL, without fetching the page they lead to, and showing where they end.
+
+Examples:
+
+$ sysbox shorten https://github.com/skx/sysbox
+$ sysbox shorten -service tinyurl https://example.com/a/long/path
+$ sysbox shorten -expand https://is.gd/example`
+}
+
+// shorten sends the URL to the service, returning the short URL.
+func (s *shortenCommand) shorten(hg *httpGetCommand, service shortenService, long string) (string, error) {
+
+	hg.form = append(stringList{"url=" + long}, service.fields...)
+	body, contentType, err := hg.requestBody()
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, service.endpoint, body)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	response, err := hg.do(req)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	data, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return "", err
+	}
+	text := strings.TrimSpace(string(data))
+
+	// Services report errors in their responses, with a variety of
+	// status codes.
+	result, message := text, ""
+	if service.result != "" {
+		var fields map[string]interface{}
+		if json.Unmarshal(data, &fields) == nil {
+			result, _ = fields[service.result].(string)
+			if fields[service.failure] != nil {
+				message = fmt.Sprintf("%v", fields[service.failure])
+			}
+		}
+	} else if response.StatusCode != http.StatusOK {
+		message = text
+	}
+
+	success := response.StatusCode == http.StatusOK || response.StatusCode == http.StatusCreated
+	if message == "" && (!success || result == "") {
+		message = response.Status
+	}
+	if message != "" {
+		return "", fmt.Errorf("%s: %s", response.Request.URL.Host, strings.SplitN(message, "\n", 2)[0])
+	}
+
+	return result, nil
+}
+
+// resolve follows the redirects of a URL, returning where they end.
+func (s *shortenCommand) resolve(hg *httpGetCommand, short string) (string, error) {
+
+	hg.noRedirect = true
+	current := short
+
+	for hops := 0; hops < 10; hops++ {
+
+		req, err := http.NewRequest(http.MethodHead, current, nil)
+		if err != nil {
+			return "", err
+		}
+
+		response, err := hg.do(req)
+		if err != nil {
+			// If we got anywhere then the destination being down
+			// doesn't matter.
+			if current != short {
+				return current, nil
+			}
+			return "", err
+		}
+		response.Body.Close()
+
+		// Only the short URL itself is worth retrying.
+		hg.retry = 0
+
+		location := response.Header.Get("Location")
+		if response.StatusCode < 300 || response.StatusCode > 399 || location == "" {
+			break
+		}
+
+		next, err := response.Request.URL.Parse(location)
+		if err != nil {
+			return "", fmt.Errorf("invalid redirect to '%s'", location)
+		}
+		current = next.String()
+	}
+
+	if current == short {
+		return "", fmt.Errorf("%s does not redirect anywhere", short)
+	}
+	return current, nil
+}
+
+// Execute is invoked if the user specifies `shorten` as the subcommand.
+func (s *shortenCommand) Execute(args []string) int {
+
+	if len(args) != 1 {
+		fmt.Printf("Usage: shorten [-service name|url] [-expand] URL\n")
+		return 1
+	}
+
+	target, err := url.Parse(args[0])
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		fmt.Printf("error: '%s' is not an http, or https, URL\n", args[0])
+		return 1
+	}
+
+	// Reuse the plumbing of http-get, for its timeout and retries.
+	hg := &httpGetCommand{timeout: s.timeout, maxRedirects: 10, retry: 2, retryDelay: time.Second}
+
+	if s.expand {
+		long, err := s.resolve(hg, target.String())
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			return 1
+		}
+		fmt.Println(long)
+		return 0
+	}
+
+	service, ok := shortenServices[strings.ToLower(s.service)]
+	if !ok {
+		if !strings.HasPrefix(s.service, "http://") && !strings.HasPrefix(s.service, "https://") {
+			fmt.Printf("error: unknown service '%s'\n", s.service)
+			return 1
+		}
+		service = shortenService{endpoint: s.service}
+	}
+
+	short, err := s.shorten(hg, service, target.String())
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+	fmt.Println(short)
+
+	return 0
+}
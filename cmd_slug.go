@@ -0,0 +1,132 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Structure for our options and state.
+type slugCommand struct {
+
+	// The maximum length of a slug, or zero for no limit.
+	maxLength int
+
+	// The separator between words.
+	separator string
+}
+
+// slugLetters maps accented, and other, Latin letters to their ASCII
+// equivalents.
+var slugLetters = map[string]string{
+	"a": "àáâãäåāăą", "c": "çćĉċč", "d": "ďđð", "e": "èéêëēĕėęě",
+	"g": "ĝğġģ", "h": "ĥħ", "i": "ìíîïĩīĭįı", "j": "ĵ", "k": "ķ",
+	"l": "ĺļľŀł", "n": "ñńņňŉ", "o": "òóôõöøōŏő", "r": "ŕŗř",
+	"s": "śŝşšș", "t": "ţťŧț", "u": "ùúûüũūŭůűų", "w": "ŵ", "y": "ýÿŷ",
+	"z": "źżž", "ss": "ß", "ae": "æ", "oe": "œ", "th": "þ",
+}
+
+// Arguments adds per-command args to the object.
+func (s *slugCommand) Arguments(f *flag.FlagSet) {
+	f.IntVar(&s.maxLength, "max-length", 0, "The maximum length of the slug, which is shortened between words")
+	f.StringVar(&s.separator, "sep", "-", "The separator to use between words")
+}
+
+// Info returns the name of this subcommand.
+func (s *slugCommand) Info() (string, string) {
+	return "slug", `Convert text to a URL-friendly slug.
+
+Details:
+
+This command converts the given text into a slug, suitable for use in
+URLs or filenames.  The text is lower-cased, accents are removed from
+Latin letters, and everything other than ASCII letters and digits is
+replaced by hyphens, with repeated hyphens collapsed and those at the
+start and end removed.
+
+If no text is given then each line of STDIN is converted in turn.
+
+The '-sep' flag changes the separator from a hyphen, and '-max-length'
+shortens slugs which are too long, without cutting words in half where
+possible.
+
+Examples:
+
+$ sysbox slug 'Héllo, Wörld!  A Story'
+hello-world-a-story
+$ sysbox slug -sep _ -max-length 20 'The Quick Brown Fox Jumps'
+the_quick_brown_fox`
+}
+
+// slugify returns the slug for the given text.
+func (s *slugCommand) slugify(text string) string {
+
+	// Build the transliteration table.
+	ascii := make(map[rune]string)
+	for replacement, letters := range slugLetters {
+		for _, r := range letters {
+			ascii[r] = replacement
+		}
+	}
+
+	var words []string
+	var word strings.Builder
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9'):
+			word.WriteRune(r)
+		case ascii[r] != "":
+			word.WriteString(ascii[r])
+		default:
+			if word.Len() > 0 {
+				words = append(words, word.String())
+				word.Reset()
+			}
+		}
+	}
+	if word.Len() > 0 {
+		words = append(words, word.String())
+	}
+
+	slug := strings.Join(words, s.separator)
+	if s.maxLength <= 0 || len(slug) <= s.maxLength {
+		return slug
+	}
+
+	// Drop whole words until we fit, unless there's only one.
+	for len(words) > 1 && len(strings.Join(words, s.separator)) > s.maxLength {
+		words = words[:len(words)-1]
+	}
+	slug = strings.Join(words, s.separator)
+	if len(slug) > s.maxLength {
+		slug = slug[:s.maxLength]
+	}
+	return slug
+}
+
+// Execute is invoked if the user specifies `slug` as the subcommand.
+func (s *slugCommand) Execute(args []string) int {
+
+	if s.maxLength < 0 {
+		fmt.Printf("error: -max-length must not be negative\n")
+		return 1
+	}
+
+	if len(args) > 0 {
+		fmt.Println(s.slugify(strings.Join(args, " ")))
+		return 0
+	}
+
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		fmt.Println(s.slugify(scanner.Text()))
+	}
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintf(os.Stderr, "error reading STDIN: %s\n", err.Error())
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Structure for our options and state.
+type sortCommand struct {
+
+	// Reverse the order of the results?
+	reverse bool
+
+	// Compare numerically?
+	numeric bool
+
+	// Compare numerically, allowing suffixes such as "2K"?
+	human bool
+
+	// Output only the first of lines which compare equally?
+	unique bool
+
+	// Ignore case when comparing?
+	fold bool
+
+	// The field to sort upon, or zero for the whole line.
+	key int
+
+	// The field delimiter, if not whitespace.
+	delimiter string
+}
+
+// sortSuffixes are the multipliers for the suffixes of human-readable
+// numbers.
+var sortSuffixes = map[byte]float64{
+	'K': 1 << 10,
+	'M': 1 << 20,
+	'G': 1 << 30,
+	'T': 1 << 40,
+	'P': 1 << 50,
+	'E': 1 << 60,
+}
+
+// Arguments adds per-command args to the object.
+func (s *sortCommand) Arguments(f *flag.FlagSet) {
+	f.BoolVar(&s.reverse, "r", false, "Reverse the order of the results")
+	f.BoolVar(&s.numeric, "n", false, "Compare lines numerically")
+	f.BoolVar(&s.human, "human", false, "Compare lines numerically, allowing suffixes such as '2K' or '1.5G'")
+	f.BoolVar(&s.unique, "u", false, "Output only the first of lines which compare equally")
+	f.BoolVar(&s.fold, "f", false, "Ignore case when comparing lines")
+	f.IntVar(&s.key, "k", 0, "Sort upon the given field, counting from 1")
+	f.StringVar(&s.delimiter, "t", "", "The delimiter between fields, instead of whitespace")
+}
+
+// Info returns the name of this subcommand.
+func (s *sortCommand) Info() (string, string) {
+	return "sort", `Sort lines of text.
+
+Details:
+
+This command sorts the lines of the named files, or STDIN if none are
+given, and outputs the result.  The sort is stable, so lines which
+compare equally are output in the order in which they were read.
+
+By default lines are compared as text, '-f' ignores case, and '-n'
+compares them as numbers.  The '-human' flag compares numbers which
+may have a suffix of K, M, G, T, P, or E, such as those output by
+'du -h'.  Lines which don't begin with a number are treated as zero.
+
+The '-k' flag sorts upon a single field of each line, rather than the
+whole line.  Fields are separated by whitespace, or by the delimiter
+given via '-t'.
+
+The '-r' flag reverses the results, and '-u' shows only the first of
+each group of lines which compare equally.
+
+Examples:
+
+$ sysbox sort -u names.txt
+$ sysbox sort -t : -k 3 -n /etc/passwd
+$ sysbox du -h -max-depth 1 | sysbox sort -human -r`
+}
+
+// field returns the part of the line which is to be compared.
+func (s *sortCommand) field(line string) string {
+
+	if s.key < 1 {
+		return line
+	}
+
+	var fields []string
+	if s.delimiter == "" {
+		fields = strings.Fields(line)
+	} else {
+		fields = strings.Split(line, s.delimiter)
+	}
+
+	if s.key > len(fields) {
+		return ""
+	}
+	return fields[s.key-1]
+}
+
+// number returns the numeric value at the start of the given text.
+func (s *sortCommand) number(text string) float64 {
+
+	text = strings.TrimSpace(text)
+
+	end := 0
+	if end < len(text) && (text[end] == '-' || text[end] == '+') {
+		end++
+	}
+	for end < len(text) && strings.IndexByte(".0123456789", text[end]) >= 0 {
+		end++
+	}
+
+	value, err := strconv.ParseFloat(text[:end], 64)
+	if err != nil {
+		return 0
+	}
+
+	if s.human && end < len(text) {
+		if multiplier, ok := sortSuffixes[text[end]&^0x20]; ok {
+			value *= multiplier
+		}
+	}
+	return value
+}
+
+// compare returns -1, 0, or +1, depending on the order of the two lines.
+func (s *sortCommand) compare(a string, b string) int {
+
+	a = s.field(a)
+	b = s.field(b)
+
+	if s.numeric || s.human {
+		x, y := s.number(a), s.number(b)
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+		return 0
+	}
+
+	if s.fold {
+		a = strings.ToLower(a)
+		b = strings.ToLower(b)
+	}
+	return strings.Compare(a, b)
+}
+
+// read appends the lines of the given reader to our results.
+func (s *sortCommand) read(in io.Reader, lines []string) ([]string, error) {
+
+	reader := bufio.NewReader(in)
+	for {
+		line, err := reader.ReadString('\n')
+		if len(line) > 0 {
+			lines = append(lines, strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r"))
+		}
+		if err == io.EOF {
+			return lines, nil
+		}
+		if err != nil {
+			return lines, err
+		}
+	}
+}
+
+// Execute is invoked if the user specifies `sort` as the subcommand.
+func (s *sortCommand) Execute(args []string) int {
+
+	if s.key < 0 {
+		fmt.Printf("error: -k must not be negative\n")
+		return 1
+	}
+	if s.numeric && s.human {
+		fmt.Printf("error: -n and -human may not be used together\n")
+		return 1
+	}
+
+	// Default to reading STDIN.
+	if len(args) == 0 {
+		args = []string{"-"}
+	}
+
+	ret := 0
+
+	var lines []string
+	var err error
+
+	for _, path := range args {
+		if path == "-" {
+			lines, err = s.read(os.Stdin, lines)
+		} else {
+			var file *os.File
+			file, err = os.Open(path)
+			if err == nil {
+				lines, err = s.read(file, lines)
+				file.Close()
+			}
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+			ret = 1
+		}
+	}
+
+	sort.SliceStable(lines, func(i, j int) bool {
+		if s.reverse {
+			return s.compare(lines[i], lines[j]) > 0
+		}
+		return s.compare(lines[i], lines[j]) < 0
+	})
+
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+
+	for i, line := range lines {
+		if s.unique && i > 0 && s.compare(lines[i-1], line) == 0 {
+			continue
+		}
+		out.WriteString(line)
+		out.WriteString("\n")
+	}
+
+	return ret
+}
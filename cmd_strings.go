@@ -0,0 +1,176 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Structure for our options and state.
+type stringsCommand struct {
+
+	// The minimum number of characters in a string.
+	length int
+
+	// The radix of offsets to show, if any.
+	radix string
+
+	// Find UTF-8 strings too?
+	utf8 bool
+}
+
+// Arguments adds per-command args to the object.
+func (s *stringsCommand) Arguments(f *flag.FlagSet) {
+	f.IntVar(&s.length, "n", 4, "The minimum number of characters in a string")
+	f.StringVar(&s.radix, "t", "", "Show the offset of each string, in decimal (d), hex (x), or octal (o)")
+	f.BoolVar(&s.utf8, "utf8", false, "Find strings containing UTF-8 characters, not just ASCII")
+}
+
+// Info returns the name of this subcommand.
+func (s *stringsCommand) Info() (string, string) {
+	return "strings", `Show the printable strings in files.
+
+Details:
+
+This command shows the sequences of printable characters, of at least
+four characters, found in the named files, or STDIN if none are given,
+in the same way as the binutils 'strings' tool.  This is useful for
+finding out what an unknown binary might be.
+
+By default only ASCII characters are found, the '-utf8' flag finds
+strings containing printable UTF-8 characters too.  The minimum length
+may be changed via '-n'.
+
+The '-t' flag shows the offset of each string within its file, in
+decimal (d), hexadecimal (x), or octal (o).
+
+Examples:
+
+$ sysbox strings /bin/ls
+$ sysbox strings -n 8 -t x firmware.bin`
+}
+
+// printable returns true if the rune may be part of a string.
+func (s *stringsCommand) printable(r rune, size int) bool {
+
+	if r == '\t' || (r >= 0x20 && r < 0x7f) {
+		return true
+	}
+	if !s.utf8 || r < 0x80 || (r == utf8.RuneError && size == 1) {
+		return false
+	}
+	return unicode.IsPrint(r)
+}
+
+// process shows the strings in the given reader.
+func (s *stringsCommand) process(in io.Reader, out *bufio.Writer) error {
+
+	reader := bufio.NewReader(in)
+
+	var current []byte
+	count := 0
+	offset := int64(0)
+	start := int64(0)
+
+	show := func() {
+		if count >= s.length {
+			switch s.radix {
+			case "d":
+				fmt.Fprintf(out, "%7d ", start)
+			case "x":
+				fmt.Fprintf(out, "%7x ", start)
+			case "o":
+				fmt.Fprintf(out, "%7o ", start)
+			}
+			out.Write(current)
+			out.WriteString("\n")
+		}
+		current = current[:0]
+		count = 0
+	}
+
+	for {
+		var r rune
+		var size int
+		var err error
+
+		// Without UTF-8 support every byte is a character.
+		if s.utf8 {
+			r, size, err = reader.ReadRune()
+		} else {
+			var b byte
+			b, err = reader.ReadByte()
+			r, size = rune(b), 1
+		}
+		if err == io.EOF {
+			show()
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if s.printable(r, size) {
+			if count == 0 {
+				start = offset
+			}
+			if s.utf8 {
+				current = append(current, string(r)...)
+			} else {
+				current = append(current, byte(r))
+			}
+			count++
+		} else {
+			show()
+		}
+		offset += int64(size)
+	}
+}
+
+// Execute is invoked if the user specifies `strings` as the subcommand.
+func (s *stringsCommand) Execute(args []string) int {
+
+	if s.length < 1 {
+		fmt.Printf("error: -n must be at least 1\n")
+		return 1
+	}
+	if s.radix != "" && s.radix != "d" && s.radix != "x" && s.radix != "o" {
+		fmt.Printf("error: -t must be 'd', 'x', or 'o'\n")
+		return 1
+	}
+
+	// Default to reading STDIN.
+	if len(args) == 0 {
+		args = []string{"-"}
+	}
+
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+
+	ret := 0
+
+	for _, path := range args {
+		var err error
+		if path == "-" {
+			err = s.process(os.Stdin, out)
+		} else {
+			var file *os.File
+			file, err = os.Open(path)
+			if err == nil {
+				err = s.process(file, out)
+				file.Close()
+			}
+		}
+		if err != nil {
+			out.Flush()
+			fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+			ret = 1
+		}
+	}
+
+	return ret
+}
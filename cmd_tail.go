@@ -0,0 +1,307 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// Structure for our options and state.
+type tailCommand struct {
+
+	// The number of lines to show.
+	lines int
+
+	// Follow the files, showing data as it is appended?
+	follow bool
+
+	// How often to check the files for changes, when following them.
+	interval time.Duration
+
+	// Show a header before the output of each file?
+	headers bool
+
+	// The name of the file we last showed output from.
+	last string
+}
+
+// tailFile holds the state of a file we're following.
+type tailFile struct {
+
+	// The name of the file.
+	name string
+
+	// The open handle.
+	file *os.File
+
+	// The details of the file, used to detect rotation.
+	info os.FileInfo
+
+	// The offset we've read up to.
+	offset int64
+}
+
+// Arguments adds per-command args to the object.
+func (t *tailCommand) Arguments(f *flag.FlagSet) {
+	f.IntVar(&t.lines, "n", 10, "The number of lines to show")
+	f.BoolVar(&t.follow, "f", false, "Follow the files, showing data as it is appended")
+	f.DurationVar(&t.interval, "interval", time.Second, "How often to check the files for changes, when following them")
+}
+
+// Info returns the name of this subcommand.
+func (t *tailCommand) Info() (string, string) {
+	return "tail", `Show the end of files, optionally following them.
+
+Details:
+
+This command shows the last lines of each named file, or of STDIN if no
+files are given.  By default ten lines are shown, but this may be changed
+via '-n'.
+
+With '-f' the files are followed, so that data appended to them is shown
+as it arrives, until the command is interrupted.  Files are polled for
+changes, which works upon all systems and filesystems, and '-interval'
+controls how often this happens.
+
+Following handles files which are truncated, in which case output resumes
+from the start, and files which are rotated away and replaced, in which
+case the new file is reopened.
+
+When several files are given the output of each is preceded by a header
+such as '==> /var/log/syslog <=='.
+
+Examples:
+
+$ sysbox tail -n 20 /var/log/syslog
+$ sysbox tail -f /var/log/nginx/access.log /var/log/nginx/error.log`
+}
+
+// header shows the header for the named file, if this is needed.
+func (t *tailCommand) header(name string) {
+
+	if !t.headers || t.last == name {
+		return
+	}
+	if t.last != "" {
+		fmt.Println()
+	}
+	fmt.Printf("==> %s <==\n", name)
+	t.last = name
+}
+
+// startOffset returns the offset within the file at which the last lines
+// begin, by reading backwards from its end.
+func (t *tailCommand) startOffset(file *os.File, size int64) (int64, error) {
+
+	if t.lines <= 0 {
+		return size, nil
+	}
+
+	buf := make([]byte, 4096)
+	count := 0
+
+	end := size
+	for end > 0 {
+		start := end - int64(len(buf))
+		if start < 0 {
+			start = 0
+		}
+
+		chunk := buf[:end-start]
+		if _, err := file.ReadAt(chunk, start); err != nil && err != io.EOF {
+			return 0, err
+		}
+
+		for i := len(chunk) - 1; i >= 0; i-- {
+
+			// A trailing newline doesn't start a new line.
+			if chunk[i] != '\n' || start+int64(i) == size-1 {
+				continue
+			}
+
+			count++
+			if count == t.lines {
+				return start + int64(i) + 1, nil
+			}
+		}
+
+		end = start
+	}
+
+	return 0, nil
+}
+
+// open opens the named file, and shows its last lines.
+func (t *tailCommand) open(name string) (*tailFile, error) {
+
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	offset, err := t.startOffset(file, info.Size())
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	tf := &tailFile{name: name, file: file, info: info, offset: offset}
+
+	t.header(name)
+	if err := t.read(tf); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return tf, nil
+}
+
+// read shows any data which is available from the given file.
+func (t *tailCommand) read(tf *tailFile) error {
+
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := tf.file.Read(buf)
+		if n > 0 {
+			t.header(tf.name)
+			os.Stdout.Write(buf[:n])
+			tf.offset += int64(n)
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// check looks for changes to the given file, showing any new data.
+func (t *tailCommand) check(tf *tailFile) {
+
+	info, err := os.Stat(tf.name)
+	if err != nil {
+
+		// The file might be in the process of being rotated,
+		// so we'll keep trying until it reappears.
+		return
+	}
+
+	// Rotated?  Show anything written to the old file before
+	// switching to the new one.
+	if !os.SameFile(tf.info, info) {
+		t.read(tf)
+
+		file, err := os.Open(tf.name)
+		if err != nil {
+			return
+		}
+		tf.file.Close()
+
+		fmt.Fprintf(os.Stderr, "tail: %s has been replaced, following the new file\n", tf.name)
+		tf.file = file
+		tf.info = info
+		tf.offset = 0
+	} else if info.Size() < tf.offset {
+		fmt.Fprintf(os.Stderr, "tail: %s: file truncated\n", tf.name)
+		if _, err := tf.file.Seek(0, io.SeekStart); err != nil {
+			return
+		}
+		tf.offset = 0
+	}
+
+	if err := t.read(tf); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+	}
+}
+
+// stdin shows the last lines of STDIN.
+func (t *tailCommand) stdin() error {
+
+	var lines [][]byte
+
+	reader := bufio.NewReader(os.Stdin)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 && t.lines > 0 {
+			lines = append(lines, line)
+			if len(lines) > t.lines {
+				lines = lines[1:]
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, line := range lines {
+		os.Stdout.Write(line)
+	}
+	return nil
+}
+
+// Execute is invoked if the user specifies `tail` as the subcommand.
+func (t *tailCommand) Execute(args []string) int {
+
+	if t.lines < 0 {
+		fmt.Printf("error: -n must not be negative\n")
+		return 1
+	}
+	if t.interval <= 0 {
+		fmt.Printf("error: -interval must be positive\n")
+		return 1
+	}
+
+	if len(args) == 0 {
+		if err := t.stdin(); err != nil {
+			fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+			return 1
+		}
+		return 0
+	}
+
+	t.headers = len(args) > 1
+
+	ret := 0
+
+	var files []*tailFile
+	for _, name := range args {
+		tf, err := t.open(name)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+			ret = 1
+			continue
+		}
+		files = append(files, tf)
+	}
+
+	if !t.follow || len(files) == 0 {
+		for _, tf := range files {
+			tf.file.Close()
+		}
+		return ret
+	}
+
+	// Poll for changes, until we're killed.
+	for {
+		time.Sleep(t.interval)
+		for _, tf := range files {
+			t.check(tf)
+		}
+	}
+}
@@ -0,0 +1,435 @@
+package main
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Structure for our options and state.
+type tarCommand struct {
+
+	// Create an archive?
+	create bool
+
+	// Extract an archive?
+	extract bool
+
+	// List the contents of an archive?
+	list bool
+
+	// Use gzip compression?
+	gzip bool
+
+	// The archive to read, or write, rather than STDIN/STDOUT.
+	file string
+
+	// The directory to extract into.
+	directory string
+
+	// Show the names of the entries as they're processed?
+	verbose bool
+}
+
+// Arguments adds per-command args to the object.
+func (t *tarCommand) Arguments(f *flag.FlagSet) {
+	f.BoolVar(&t.create, "c", false, "Create an archive from the given files and directories")
+	f.BoolVar(&t.extract, "x", false, "Extract an archive")
+	f.BoolVar(&t.list, "t", false, "List the contents of an archive")
+	f.BoolVar(&t.gzip, "z", false, "Compress the archive with gzip, when creating it")
+	f.StringVar(&t.file, "f", "-", "The archive to read, or write, with '-' meaning STDIN/STDOUT")
+	f.StringVar(&t.directory, "C", ".", "The directory to extract into")
+	f.BoolVar(&t.verbose, "v", false, "Show the name of each entry as it is processed")
+}
+
+// Info returns the name of this subcommand.
+func (t *tarCommand) Info() (string, string) {
+	return "tar", `Create, extract, or list tar archives.
+
+Details:
+
+This command works with tar archives, in one of three modes:
+
+   -c  Create an archive, from the given files and directories.
+   -x  Extract an archive, optionally into the directory named via '-C'.
+   -t  List the contents of an archive.
+
+The archive is named via '-f', and defaults to STDIN, or STDOUT when
+creating an archive.  The '-z' flag compresses a new archive with gzip,
+and compressed archives are detected automatically when reading them.
+
+Directories are archived recursively, and the permissions, and
+modification times, of entries are preserved.  Entries are streamed, so
+archives of any size may be processed.
+
+When extracting any entry which would be written outside the target
+directory, for example by using '../' in its name, is refused.
+
+Examples:
+
+$ sysbox tar -c -z -f backup.tar.gz /etc
+$ sysbox tar -t -f backup.tar.gz
+$ sysbox tar -x -C /tmp/restore -f backup.tar.gz`
+}
+
+// add writes the given path, and everything beneath it, to the archive.
+func (t *tarCommand) add(tw *tar.Writer, root string) error {
+
+	return filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		link := ""
+		if info.Mode()&os.ModeSymlink != 0 {
+			if link, err = os.Readlink(path); err != nil {
+				return err
+			}
+		}
+
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "skipping %s: %s\n", path, err.Error())
+			return nil
+		}
+
+		// Archive names are relative, and use forward-slashes.
+		name := strings.TrimLeft(filepath.ToSlash(path), "/")
+		if name == "" {
+			return nil
+		}
+		if info.IsDir() {
+			name = strings.TrimSuffix(name, "/") + "/"
+		}
+		header.Name = name
+
+		if t.verbose {
+			fmt.Fprintln(os.Stderr, name)
+		}
+
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		_, err = io.Copy(tw, file)
+		return err
+	})
+}
+
+// createArchive writes an archive of the given paths.
+func (t *tarCommand) createArchive(out io.Writer, paths []string) error {
+
+	var gz *gzip.Writer
+	if t.gzip {
+		gz = gzip.NewWriter(out)
+		out = gz
+	}
+
+	tw := tar.NewWriter(out)
+	for _, path := range paths {
+		if err := t.add(tw, path); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	if gz != nil {
+		return gz.Close()
+	}
+	return nil
+}
+
+// reader returns a reader for the archive, decompressing it if required.
+func (t *tarCommand) reader(in io.Reader) (*tar.Reader, error) {
+
+	buffered := bufio.NewReader(in)
+
+	// gzip streams start with a magic number.
+	magic, _ := buffered.Peek(2)
+	if len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(buffered)
+		if err != nil {
+			return nil, err
+		}
+		return tar.NewReader(gz), nil
+	}
+	return tar.NewReader(buffered), nil
+}
+
+// listArchive shows the names of the entries in the archive.
+func (t *tarCommand) listArchive(in io.Reader) error {
+
+	tr, err := t.reader(in)
+	if err != nil {
+		return err
+	}
+
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if t.verbose {
+			fmt.Printf("%s %10d %s %s\n", header.FileInfo().Mode(), header.Size, header.ModTime.Format("2006-01-02 15:04"), header.Name)
+		} else {
+			fmt.Println(header.Name)
+		}
+	}
+}
+
+// inside returns true if the path is within the given directory.
+func inside(dir string, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// resolved returns the real location of the path, following any symlinks
+// within the part of it which already exists, so that links extracted from
+// an archive can't be used to escape the target directory.
+func resolved(path string) (string, error) {
+
+	existing, rest := path, ""
+	for {
+		real, err := filepath.EvalSymlinks(existing)
+		if err == nil {
+			return filepath.Join(real, rest), nil
+		}
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+
+		// A dangling symlink could point anywhere, once its target exists.
+		if _, lerr := os.Lstat(existing); lerr == nil {
+			return "", fmt.Errorf("%s is a dangling symlink", existing)
+		}
+
+		parent := filepath.Dir(existing)
+		if parent == existing {
+			return path, nil
+		}
+		rest = filepath.Join(filepath.Base(existing), rest)
+		existing = parent
+	}
+}
+
+// extractArchive extracts the entries of the archive.
+func (t *tarCommand) extractArchive(in io.Reader) error {
+
+	tr, err := t.reader(in)
+	if err != nil {
+		return err
+	}
+
+	dest, err := filepath.Abs(t.directory)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dest, 0755); err != nil {
+		return err
+	}
+	realDest, err := filepath.EvalSymlinks(dest)
+	if err != nil {
+		return err
+	}
+
+	// escapes returns true if the path, once symlinks are followed, is
+	// outside the target directory.
+	escapes := func(path string) bool {
+		real, err := resolved(path)
+		return err != nil || !inside(realDest, real)
+	}
+
+	// Directory times are set last, as creating their contents
+	// will update them.
+	dirTimes := make(map[string]time.Time)
+
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(dest, filepath.FromSlash(header.Name))
+		if filepath.IsAbs(filepath.FromSlash(header.Name)) || !inside(dest, target) {
+			return fmt.Errorf("refusing to extract %s, outside the target directory", header.Name)
+		}
+
+		// The entry's directory may contain symlinks we've extracted.
+		parent, err := resolved(filepath.Dir(target))
+		if err != nil || !inside(realDest, parent) {
+			return fmt.Errorf("refusing to extract %s, which is through a symlink outside the target directory", header.Name)
+		}
+
+		// Replace existing symlinks, rather than writing through them.
+		if info, err := os.Lstat(target); err == nil && info.Mode()&os.ModeSymlink != 0 && header.Typeflag != tar.TypeDir {
+			os.Remove(target)
+		}
+
+		if t.verbose {
+			fmt.Fprintln(os.Stderr, header.Name)
+		}
+
+		mode := header.FileInfo().Mode()
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if escapes(target) {
+				return fmt.Errorf("refusing to extract %s, which is through a symlink outside the target directory", header.Name)
+			}
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+			if err := os.Chmod(target, mode.Perm()); err != nil {
+				return err
+			}
+			dirTimes[target] = header.ModTime
+			continue
+
+		case tar.TypeReg, tar.TypeRegA:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			file, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode.Perm())
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(file, tr)
+			file.Close()
+			if err != nil {
+				return err
+			}
+			if err := os.Chmod(target, mode.Perm()); err != nil {
+				return err
+			}
+
+		case tar.TypeSymlink:
+			link := filepath.Join(filepath.Dir(target), filepath.FromSlash(header.Linkname))
+			if filepath.IsAbs(header.Linkname) || !inside(dest, link) || escapes(filepath.Join(parent, filepath.FromSlash(header.Linkname))) {
+				return fmt.Errorf("refusing to extract %s, which links outside the target directory", header.Name)
+			}
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			os.Remove(target)
+			if err := os.Symlink(header.Linkname, target); err != nil {
+				return err
+			}
+			continue
+
+		case tar.TypeLink:
+			link := filepath.Join(dest, filepath.FromSlash(header.Linkname))
+			if !inside(dest, link) || escapes(link) {
+				return fmt.Errorf("refusing to extract %s, which links outside the target directory", header.Name)
+			}
+			os.Remove(target)
+			if err := os.Link(link, target); err != nil {
+				return err
+			}
+
+		default:
+			fmt.Fprintf(os.Stderr, "skipping %s: unsupported entry type\n", header.Name)
+			continue
+		}
+
+		if err := os.Chtimes(target, header.ModTime, header.ModTime); err != nil {
+			return err
+		}
+	}
+
+	for dir, mtime := range dirTimes {
+		os.Chtimes(dir, mtime, mtime)
+	}
+	return nil
+}
+
+// Execute is invoked if the user specifies `tar` as the subcommand.
+func (t *tarCommand) Execute(args []string) int {
+
+	modes := 0
+	for _, set := range []bool{t.create, t.extract, t.list} {
+		if set {
+			modes++
+		}
+	}
+	if modes != 1 {
+		fmt.Printf("Usage: tar -c [-z] [-f archive] path [path ...]\n")
+		fmt.Printf("       tar -x [-C directory] [-f archive]\n")
+		fmt.Printf("       tar -t [-f archive]\n")
+		return 1
+	}
+	if t.create && len(args) == 0 {
+		fmt.Printf("error: no files, or directories, given to archive\n")
+		return 1
+	}
+	if !t.create && len(args) != 0 {
+		fmt.Printf("error: unexpected arguments\n")
+		return 1
+	}
+
+	var err error
+
+	if t.create {
+		var out io.Writer = os.Stdout
+		if t.file != "-" {
+			file, ferr := os.Create(t.file)
+			if ferr != nil {
+				fmt.Printf("error: %s\n", ferr.Error())
+				return 1
+			}
+			defer file.Close()
+			out = file
+		}
+		err = t.createArchive(out, args)
+	} else {
+		var in io.Reader = os.Stdin
+		if t.file != "-" {
+			file, ferr := os.Open(t.file)
+			if ferr != nil {
+				fmt.Printf("error: %s\n", ferr.Error())
+				return 1
+			}
+			defer file.Close()
+			in = file
+		}
+
+		if t.extract {
+			err = t.extractArchive(in)
+		} else {
+			err = t.listArchive(in)
+		}
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+		return 1
+	}
+	return 0
+}
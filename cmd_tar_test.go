@@ -0,0 +1,100 @@
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// tarEntry is an entry of an archive we build for testing.
+type tarEntry struct {
+	name     string
+	linkname string
+	body     string
+}
+
+// buildTar returns an archive containing the given entries, which are
+// symlinks if they have a linkname, and files otherwise.
+func buildTar(t *testing.T, entries []tarEntry) *bytes.Buffer {
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+
+	for _, e := range entries {
+		header := &tar.Header{Name: e.name, Mode: 0644, Typeflag: tar.TypeReg, Size: int64(len(e.body))}
+		if e.linkname != "" {
+			header = &tar.Header{Name: e.name, Mode: 0777, Typeflag: tar.TypeSymlink, Linkname: e.linkname}
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			t.Fatalf("failed to write header: %s", err)
+		}
+		if _, err := tw.Write([]byte(e.body)); err != nil {
+			t.Fatalf("failed to write body: %s", err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatalf("failed to close archive: %s", err)
+	}
+	return &buf
+}
+
+// TestTarSymlinkTraversal ensures that symlinks extracted from an archive
+// can't be chained to write outside the target directory.
+func TestTarSymlinkTraversal(t *testing.T) {
+
+	tmp, err := ioutil.TempDir("", "tar")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %s", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	archive := buildTar(t, []tarEntry{
+		{name: "a", linkname: "."},
+		{name: "a/b", linkname: ".."},
+		{name: "b/evil.txt", body: "evil"},
+	})
+
+	out := filepath.Join(tmp, "out")
+	cmd := &tarCommand{directory: out}
+	if err := cmd.extractArchive(archive); err == nil {
+		t.Fatalf("expected an error extracting a traversing archive")
+	}
+
+	if _, err := os.Stat(filepath.Join(tmp, "evil.txt")); err == nil {
+		t.Fatalf("a file was written outside the target directory")
+	}
+}
+
+// TestTarExtract ensures that archives with safe symlinks are extracted.
+func TestTarExtract(t *testing.T) {
+
+	tmp, err := ioutil.TempDir("", "tar")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %s", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	archive := buildTar(t, []tarEntry{
+		{name: "dir/file.txt", body: "hello"},
+		{name: "link", linkname: "dir"},
+		{name: "link/other.txt", body: "world"},
+	})
+
+	cmd := &tarCommand{directory: tmp}
+	if err := cmd.extractArchive(archive); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for name, expected := range map[string]string{"dir/file.txt": "hello", "dir/other.txt": "world"} {
+		data, err := ioutil.ReadFile(filepath.Join(tmp, name))
+		if err != nil {
+			t.Fatalf("failed to read %s: %s", name, err)
+		}
+		if string(data) != expected {
+			t.Fatalf("%s contained '%s', expected '%s'", name, data, expected)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Structure for our options and state.
+type timerCommand struct {
+
+	// Show a progress bar?
+	progress bool
+
+	// The command to run when the timer finishes.
+	exec string
+
+	// The message to show when the timer finishes.
+	message string
+}
+
+// timerClock matches durations such as "1:30" or "1:02:03".
+var timerClock = regexp.MustCompile(`^(?:(\d+):)?(\d+):(\d+)$`)
+
+// Arguments adds per-command args to the object.
+func (t *timerCommand) Arguments(f *flag.FlagSet) {
+	f.BoolVar(&t.progress, "progress", false, "Show a progress bar")
+	f.StringVar(&t.exec, "exec", "", "The command to run when the timer finishes")
+	f.StringVar(&t.message, "message", "Time's up!", "The message to show when the timer finishes")
+}
+
+// Info returns the name of this subcommand.
+func (t *timerCommand) Info() (string, string) {
+	return "timer", `Count down for a period of time.
+
+Details:
+
+This command counts down for the given duration, showing the time which
+remains, then rings the terminal bell and shows a message.
+
+Durations may be given in any of the forms:
+
+   90s, 5m, 1h30m, or '1h 30m'
+   90                 A number of seconds.
+   1:30, or 1:02:03   Minutes and seconds, or hours, minutes, and seconds.
+   2d                 A number of days.
+
+The '-progress' flag shows a progress bar, '-message' changes the message
+shown at the end, and '-exec' runs a command once the timer finishes.
+The command is not passed to a shell.
+
+Pressing Ctrl-C cancels the timer, without running the command.
+
+Examples:
+
+$ sysbox timer 5m
+$ sysbox timer -progress -message 'Tea is ready' 4m
+$ sysbox timer -exec 'notify-send Break' 25m`
+}
+
+// parse parses a duration.
+func (t *timerCommand) parse(spec string) (time.Duration, error) {
+
+	spec = strings.Replace(strings.TrimSpace(spec), " ", "", -1)
+
+	if d, err := time.ParseDuration(spec); err == nil {
+		return d, nil
+	}
+
+	// A plain number of seconds.
+	if n, err := strconv.ParseFloat(spec, 64); err == nil {
+		return time.Duration(n * float64(time.Second)), nil
+	}
+
+	// A clock-like time, such as "1:30".
+	if m := timerClock.FindStringSubmatch(spec); m != nil {
+		hours, _ := strconv.Atoi("0" + m[1])
+		minutes, _ := strconv.Atoi(m[2])
+		seconds, _ := strconv.Atoi(m[3])
+		return time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second, nil
+	}
+
+	// Days, optionally followed by a shorter duration, such as "1d12h".
+	if i := strings.Index(spec, "d"); i > 0 {
+		days, err := strconv.ParseFloat(spec[:i], 64)
+		if err == nil {
+			d := time.Duration(days * 24 * float64(time.Hour))
+			if i == len(spec)-1 {
+				return d, nil
+			}
+			if rest, err := time.ParseDuration(spec[i+1:]); err == nil {
+				return d + rest, nil
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("invalid duration '%s'", spec)
+}
+
+// format returns the duration as HH:MM:SS, rounding up to the next second.
+func (t *timerCommand) format(d time.Duration) string {
+
+	secs := int64((d + time.Second - 1) / time.Second)
+	return fmt.Sprintf("%02d:%02d:%02d", secs/3600, secs/60%60, secs%60)
+}
+
+// show updates the display, in place.
+func (t *timerCommand) show(remaining time.Duration, total time.Duration) {
+
+	line := t.format(remaining)
+
+	if t.progress {
+		width := 40
+		done := float64(total-remaining) / float64(total)
+		filled := int(done * float64(width))
+		line += fmt.Sprintf(" [%s%s] %3.0f%%", strings.Repeat("#", filled), strings.Repeat(".", width-filled), done*100)
+	}
+
+	fmt.Printf("\r%s", line)
+}
+
+// Execute is invoked if the user specifies `timer` as the subcommand.
+func (t *timerCommand) Execute(args []string) int {
+
+	if len(args) < 1 {
+		fmt.Printf("Usage: timer [-progress] [-message text] [-exec command] duration\n")
+		return 1
+	}
+
+	total, err := t.parse(strings.Join(args, " "))
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+	if total <= 0 {
+		fmt.Printf("error: the duration must be positive\n")
+		return 1
+	}
+	if t.exec != "" && len(strings.Fields(t.exec)) == 0 {
+		fmt.Printf("error: -exec requires a command\n")
+		return 1
+	}
+
+	// Catch Ctrl-C, so we can cancel cleanly.
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	end := time.Now().Add(total)
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	t.show(total, total)
+
+	for remaining := total; remaining > 0; {
+		select {
+		case <-interrupt:
+			fmt.Printf("\ncancelled with %s remaining\n", t.format(remaining))
+			return 1
+		case <-ticker.C:
+			remaining = time.Until(end)
+			if remaining < 0 {
+				remaining = 0
+			}
+			t.show(remaining, total)
+		}
+	}
+
+	fmt.Printf("\a\n%s\n", t.message)
+
+	if t.exec != "" {
+		args := strings.Fields(t.exec)
+		cmd := exec.Command(args[0], args[1:]...)
+		cmd.Stdin = os.Stdin
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			fmt.Printf("error running %s: %s\n", args[0], err.Error())
+			return 1
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base32"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"hash"
+	"io/ioutil"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Structure for our options and state.
+type totpCommand struct {
+
+	// Read the secret from the given file.
+	file string
+
+	// The length of each time-window.
+	period int
+
+	// The number of digits in each code.
+	digits int
+
+	// The hash algorithm to use.
+	algorithm string
+
+	// Keep showing codes, as each window begins?
+	watch bool
+}
+
+// totpAlgorithms holds the hash algorithms we support.
+var totpAlgorithms = map[string]func() hash.Hash{
+	"SHA1":   sha1.New,
+	"SHA256": sha256.New,
+	"SHA512": sha512.New,
+}
+
+// Arguments adds per-command args to the object.
+func (t *totpCommand) Arguments(f *flag.FlagSet) {
+	f.StringVar(&t.file, "f", "", "Read the secret, or otpauth:// URI, from the given file")
+	f.IntVar(&t.period, "period", 30, "The length of each time-window, in seconds")
+	f.IntVar(&t.digits, "digits", 6, "The number of digits in each code")
+	f.StringVar(&t.algorithm, "algorithm", "SHA1", "The hash algorithm to use (SHA1, SHA256, or SHA512)")
+	f.BoolVar(&t.watch, "watch", false, "Keep showing codes, as each time-window begins")
+}
+
+// Info returns the name of this subcommand.
+func (t *totpCommand) Info() (string, string) {
+	return "totp", `Generate time-based one-time passwords.
+
+Details:
+
+This command generates the current time-based one-time password, as
+described in RFC 6238, for the given secret.  These are the codes used
+for two-factor authentication by applications such as Google
+Authenticator.
+
+The secret is base32-encoded, and may be given as an argument, or read
+from a file via '-f'.  An 'otpauth://' URI, as found in enrollment QR
+codes, may be used instead, in which case any settings it contains
+override those given via flags.
+
+The code is shown along with the number of seconds until it changes.
+With '-watch' a new code is shown as each time-window begins, until the
+command is interrupted.
+
+Examples:
+
+$ sysbox totp JBSWY3DPEHPK3PXP
+$ sysbox totp -f ~/.totp/github -watch
+$ sysbox totp 'otpauth://totp/Example:steve?secret=JBSWY3DPEHPK3PXP&digits=8'`
+}
+
+// parseURI applies the settings from an otpauth:// URI, returning the
+// secret it contains.
+func (t *totpCommand) parseURI(uri string) (string, error) {
+
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", err
+	}
+	if u.Host != "totp" {
+		return "", fmt.Errorf("only otpauth://totp/ URIs are supported")
+	}
+
+	query := u.Query()
+	if value := query.Get("algorithm"); value != "" {
+		t.algorithm = value
+	}
+	if value := query.Get("digits"); value != "" {
+		if t.digits, err = strconv.Atoi(value); err != nil {
+			return "", fmt.Errorf("invalid digits '%s'", value)
+		}
+	}
+	if value := query.Get("period"); value != "" {
+		if t.period, err = strconv.Atoi(value); err != nil {
+			return "", fmt.Errorf("invalid period '%s'", value)
+		}
+	}
+
+	secret := query.Get("secret")
+	if secret == "" {
+		return "", fmt.Errorf("the URI contains no secret")
+	}
+	return secret, nil
+}
+
+// decodeSecret decodes a base32 secret, ignoring case, spaces, and padding.
+func decodeSecret(secret string) ([]byte, error) {
+
+	secret = strings.ToUpper(strings.Replace(secret, " ", "", -1))
+	secret = strings.TrimRight(secret, "=")
+
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base32 secret: %s", err.Error())
+	}
+	return key, nil
+}
+
+// code returns the code for the given time-step.
+func (t *totpCommand) code(key []byte, step int64) string {
+
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], uint64(step))
+
+	mac := hmac.New(totpAlgorithms[t.algorithm], key)
+	mac.Write(counter[:])
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation, as described in RFC 4226.
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulus := uint32(1)
+	for i := 0; i < t.digits; i++ {
+		modulus *= 10
+	}
+
+	return fmt.Sprintf("%0*d", t.digits, value%modulus)
+}
+
+// show outputs the current code, returning the time until it changes.
+func (t *totpCommand) show(key []byte) time.Duration {
+
+	now := time.Now().Unix()
+	period := int64(t.period)
+	remaining := period - now%period
+
+	fmt.Printf("%s (%ds remaining)\n", t.code(key, now/period), remaining)
+	return time.Duration(remaining) * time.Second
+}
+
+// Execute is invoked if the user specifies `totp` as the subcommand.
+func (t *totpCommand) Execute(args []string) int {
+
+	var secret string
+
+	switch {
+	case t.file != "" && len(args) == 0:
+		data, err := ioutil.ReadFile(t.file)
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			return 1
+		}
+		secret = strings.TrimSpace(string(data))
+	case t.file == "" && len(args) == 1:
+		secret = args[0]
+	default:
+		fmt.Printf("Usage: totp [-watch] secret|otpauth-uri\n")
+		fmt.Printf("       totp [-watch] -f file\n")
+		return 1
+	}
+
+	if strings.HasPrefix(secret, "otpauth://") {
+		var err error
+		secret, err = t.parseURI(secret)
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			return 1
+		}
+	}
+
+	t.algorithm = strings.ToUpper(strings.Replace(t.algorithm, "-", "", -1))
+	if _, ok := totpAlgorithms[t.algorithm]; !ok {
+		fmt.Printf("error: unknown algorithm '%s'\n", t.algorithm)
+		return 1
+	}
+	if t.digits < 1 || t.digits > 9 {
+		fmt.Printf("error: -digits must be between 1 and 9\n")
+		return 1
+	}
+	if t.period < 1 {
+		fmt.Printf("error: -period must be at least 1\n")
+		return 1
+	}
+
+	key, err := decodeSecret(secret)
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+
+	wait := t.show(key)
+	for t.watch {
+		time.Sleep(wait)
+		wait = t.show(key)
+	}
+
+	return 0
+}
@@ -0,0 +1,263 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Structure for our options and state.
+type trCommand struct {
+
+	// Delete characters in the first set?
+	delete bool
+
+	// Squeeze repeated characters?
+	squeeze bool
+
+	// Use the complement of the first set?
+	complement bool
+}
+
+// trClasses are the named character classes we support, each of which
+// matches ASCII characters, as in the C locale.
+var trClasses = map[string]func(rune) bool{
+	"alnum":  func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) },
+	"alpha":  unicode.IsLetter,
+	"blank":  func(r rune) bool { return r == ' ' || r == '\t' },
+	"cntrl":  unicode.IsControl,
+	"digit":  unicode.IsDigit,
+	"graph":  func(r rune) bool { return unicode.IsGraphic(r) && r != ' ' },
+	"lower":  unicode.IsLower,
+	"print":  unicode.IsPrint,
+	"punct":  func(r rune) bool { return unicode.IsPunct(r) || unicode.IsSymbol(r) },
+	"space":  unicode.IsSpace,
+	"upper":  unicode.IsUpper,
+	"xdigit": func(r rune) bool { return strings.ContainsRune("0123456789abcdefABCDEF", r) },
+}
+
+// Arguments adds per-command args to the object.
+func (t *trCommand) Arguments(f *flag.FlagSet) {
+	f.BoolVar(&t.delete, "d", false, "Delete characters in SET1, rather than translating them")
+	f.BoolVar(&t.squeeze, "s", false, "Squeeze repeated characters into a single character")
+	f.BoolVar(&t.complement, "c", false, "Use the complement of SET1")
+}
+
+// Info returns the name of this subcommand.
+func (t *trCommand) Info() (string, string) {
+	return "tr", `Translate, or delete, characters.
+
+Details:
+
+This command copies STDIN to STDOUT, translating or deleting characters,
+in the same way as the standard 'tr' command:
+
+   tr SET1 SET2      Replace characters in SET1 with those in SET2.
+   tr -d SET1        Delete characters in SET1.
+   tr -s SET1        Squeeze repeated characters in SET1.
+   tr -d -s SET1 SET2
+                     Delete characters in SET1, then squeeze SET2.
+
+If SET2 is shorter than SET1 its last character is repeated.  With '-s'
+when translating, repeated characters in SET2 are squeezed.  The '-c'
+flag uses every character which is not in SET1 instead.
+
+Sets may contain ranges, such as 'a-z', the escapes \n, \t, \r, \\, and
+octal \NNN, and the named classes:
+
+   [:alnum:] [:alpha:] [:blank:] [:cntrl:] [:digit:] [:graph:]
+   [:lower:] [:print:] [:punct:] [:space:] [:upper:] [:xdigit:]
+
+Classes expand to ASCII characters, so '[:lower:]' and '[:upper:]' may
+be used to change case.
+
+Examples:
+
+$ echo hello | sysbox tr a-z A-Z
+$ echo 'a  b   c' | sysbox tr -s ' '
+$ sysbox tr -d '[:digit:]' < input.txt
+$ sysbox tr -c -s '[:alnum:]' '\n' < words.txt`
+}
+
+// parseSet expands a set, such as "a-z[:digit:]", into its characters.
+func (t *trCommand) parseSet(set string) ([]rune, error) {
+
+	var out []rune
+
+	in := []rune(set)
+	for i := 0; i < len(in); i++ {
+
+		// Named classes.
+		if in[i] == '[' && i+1 < len(in) && in[i+1] == ':' {
+			end := strings.Index(string(in[i:]), ":]")
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated class in '%s'", set)
+			}
+			name := string(in[i:])[2:end]
+			class, ok := trClasses[name]
+			if !ok {
+				return nil, fmt.Errorf("unknown class '%s'", name)
+			}
+			for r := rune(0); r < 128; r++ {
+				if class(r) {
+					out = append(out, r)
+				}
+			}
+			i += len([]rune(string(in[i:])[:end+2])) - 1
+			continue
+		}
+
+		r, n := t.char(in[i:])
+		i += n - 1
+
+		// Ranges.
+		if i+2 < len(in) && in[i+1] == '-' {
+			end, m := t.char(in[i+2:])
+			if end < r {
+				return nil, fmt.Errorf("invalid range '%c-%c'", r, end)
+			}
+			for c := r; c <= end; c++ {
+				out = append(out, c)
+			}
+			i += m + 1
+			continue
+		}
+
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+// char returns the character at the start of the input, and the number
+// of runes it occupied, allowing for escapes.
+func (t *trCommand) char(in []rune) (rune, int) {
+
+	if in[0] != '\\' || len(in) < 2 {
+		return in[0], 1
+	}
+
+	switch in[1] {
+	case 'n':
+		return '\n', 2
+	case 't':
+		return '\t', 2
+	case 'r':
+		return '\r', 2
+	}
+
+	// Octal escapes, of up to three digits.
+	n := 1
+	for n < len(in) && n < 4 && in[n] >= '0' && in[n] <= '7' {
+		n++
+	}
+	if n > 1 {
+		value, _ := strconv.ParseInt(string(in[1:n]), 8, 32)
+		return rune(value), n
+	}
+
+	return in[1], 2
+}
+
+// Execute is invoked if the user specifies `tr` as the subcommand.
+func (t *trCommand) Execute(args []string) int {
+
+	want := 2
+	if t.delete && !t.squeeze || !t.delete && t.squeeze && len(args) == 1 {
+		want = 1
+	}
+	if len(args) != want {
+		fmt.Printf("Usage: tr [-c] [-d] [-s] SET1 [SET2]\n")
+		return 1
+	}
+
+	var sets [][]rune
+	for _, arg := range args {
+		set, err := t.parseSet(arg)
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			return 1
+		}
+		sets = append(sets, set)
+	}
+
+	first := make(map[rune]bool)
+	for _, r := range sets[0] {
+		first[r] = true
+	}
+	inFirst := func(r rune) bool {
+		return first[r] != t.complement
+	}
+
+	// Work out how to translate characters.
+	translate := make(map[rune]rune)
+	translating := !t.delete && len(sets) == 2
+	if translating {
+		if len(sets[1]) == 0 {
+			fmt.Printf("error: SET2 must not be empty\n")
+			return 1
+		}
+		for i, r := range sets[0] {
+			if i < len(sets[1]) {
+				translate[r] = sets[1][i]
+			} else {
+				translate[r] = sets[1][len(sets[1])-1]
+			}
+		}
+	}
+
+	// The set of characters to squeeze is the last one given.
+	squeeze := make(map[rune]bool)
+	for _, r := range sets[len(sets)-1] {
+		squeeze[r] = true
+	}
+	inSqueeze := func(r rune) bool {
+		if len(sets) == 1 {
+			return inFirst(r)
+		}
+		return squeeze[r]
+	}
+
+	in := bufio.NewReader(os.Stdin)
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+
+	last := rune(-1)
+	for {
+		r, _, err := in.ReadRune()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			out.Flush()
+			fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+			return 1
+		}
+
+		if inFirst(r) {
+			if t.delete {
+				continue
+			}
+			if translating {
+				if t.complement {
+					r = sets[1][len(sets[1])-1]
+				} else {
+					r = translate[r]
+				}
+			}
+		}
+
+		if t.squeeze && r == last && inSqueeze(r) {
+			continue
+		}
+		last = r
+
+		out.WriteRune(r)
+	}
+
+	return 0
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
@@ -16,13 +17,27 @@ type treeCommand struct {
 
 	// show all files?
 	all bool
+
+	// The maximum depth to descend to, or zero for no limit.
+	depth int
+
+	// Glob patterns of entries to ignore.
+	ignore stringList
+
+	// The number of directories, and files, we've shown.
+	dirCount  int
+	fileCount int
+
+	// Did we hit an error?
+	failed bool
 }
 
 // Arguments adds per-command args to the object.
 func (t *treeCommand) Arguments(f *flag.FlagSet) {
 	f.BoolVar(&t.directories, "d", false, "Show only directories.")
 	f.BoolVar(&t.all, "a", false, "Show all files, including dotfiles.")
-
+	f.IntVar(&t.depth, "L", 0, "The maximum depth to descend to.")
+	f.Var(&t.ignore, "ignore", "Ignore entries matching the given glob, which may be repeated.")
 }
 
 // Info returns the name of this subcommand.
@@ -42,11 +57,111 @@ To show only directory entries:
 
    $ sysbox tree -d /opt
 
+To descend no more than two levels:
+
+   $ sysbox tree -L 2 /usr
+
+Entries may be ignored via '-ignore', which may be repeated.  As with
+'.gitignore' patterns without a slash match the name of an entry, those
+with a slash match its path relative to the starting directory, and
+those with a trailing slash match only directories:
+
+   $ sysbox tree -ignore '*.o' -ignore 'vendor/' .
+
+Symbolic links are shown along with their target, but are not followed.
+
 If there were any errors encounted the return-code will be 1, otherwise 0.
 
 `
 }
 
+// ignored returns true if the entry matches one of the ignore-patterns.
+func (t *treeCommand) ignored(rel string, info os.FileInfo) bool {
+
+	for _, pattern := range t.ignore {
+
+		if strings.HasSuffix(pattern, "/") {
+			if !info.IsDir() {
+				continue
+			}
+			pattern = strings.TrimSuffix(pattern, "/")
+		}
+
+		name := info.Name()
+		if strings.Contains(pattern, "/") {
+			name = filepath.ToSlash(rel)
+			pattern = strings.TrimPrefix(pattern, "/")
+		}
+
+		if match, _ := filepath.Match(pattern, name); match {
+			return true
+		}
+	}
+	return false
+}
+
+// show outputs the contents of the given directory, at the given depth,
+// with each line being preceded by the prefix.
+func (t *treeCommand) show(dir string, rel string, prefix string, depth int) {
+
+	if t.depth > 0 && depth > t.depth {
+		return
+	}
+
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		fmt.Printf("%s└── [error: %s]\n", prefix, err.Error())
+		t.failed = true
+		return
+	}
+
+	// Filter the entries we're not going to show.
+	var shown []os.FileInfo
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".") && !t.all {
+			continue
+		}
+		if t.directories && !entry.IsDir() {
+			continue
+		}
+		if t.ignored(filepath.Join(rel, entry.Name()), entry) {
+			continue
+		}
+		shown = append(shown, entry)
+	}
+
+	for i, entry := range shown {
+
+		connector, indent := "├── ", "│   "
+		if i == len(shown)-1 {
+			connector, indent = "└── ", "    "
+		}
+
+		path := filepath.Join(dir, entry.Name())
+
+		// Show the targets of symlinks, which we don't follow.
+		if entry.Mode()&os.ModeSymlink != 0 {
+			target, err := os.Readlink(path)
+			if err != nil {
+				target = "[error: " + err.Error() + "]"
+				t.failed = true
+			}
+			fmt.Printf("%s%s%s -> %s\n", prefix, connector, entry.Name(), target)
+			t.fileCount++
+			continue
+		}
+
+		fmt.Printf("%s%s%s\n", prefix, connector, entry.Name())
+
+		if entry.IsDir() {
+			t.dirCount++
+			t.show(path, filepath.Join(rel, entry.Name()), prefix+indent, depth+1)
+		} else {
+			t.fileCount++
+		}
+	}
+}
+
 // Execute is invoked if the user specifies `tree` as the subcommand.
 func (t *treeCommand) Execute(args []string) int {
 
@@ -62,71 +177,41 @@ func (t *treeCommand) Execute(args []string) int {
 		start = args[0]
 	}
 
-	type Entry struct {
-		name      string
-		error     string
-		directory bool
+	info, err := os.Stat(start)
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+	if !info.IsDir() {
+		fmt.Printf("error: %s is not a directory\n", start)
+		return 1
 	}
 
 	//
-	// Keep track of directory entries here.
-	//
-	entries := []*Entry{}
-
-	//
-	// Find the contents
-	//
-	filepath.Walk(start,
-		func(path string, info os.FileInfo, err error) error {
-
-			entry := &Entry{name: path}
-
-			if err == nil {
-				switch mode := info.Mode(); {
-				case mode.IsDir():
-					entry.directory = true
-				}
-			} else {
-				entry.error = err.Error()
-			}
-			entries = append(entries, entry)
-			return nil
-		})
-	//	if err != nil {
-	//		fmt.Printf("error walking . : %s\n", err.Error())
-	//		return 1
-	//	}
-
-	//
-	// Did we hit an error?
+	// Show the entries
 	//
-	error := false
+	fmt.Printf("%s\n", start)
+	t.show(start, "", "", 1)
 
 	//
-	// Show the entries
+	// And the summary
 	//
-	for _, ent := range entries {
-
-		// showing only directories?  Then skip this
-		// entry unless it is a directory
-		if t.directories && !ent.directory {
-			continue
-		}
-
-		// skip dotfiles by default
-		if (strings.Contains(ent.name, "/.") || strings.HasPrefix(ent.name, ".")) && !t.all {
-			continue
-		}
+	dirs := fmt.Sprintf("%d directories", t.dirCount)
+	if t.dirCount == 1 {
+		dirs = "1 directory"
+	}
+	files := fmt.Sprintf("%d files", t.fileCount)
+	if t.fileCount == 1 {
+		files = "1 file"
+	}
 
-		if ent.error != "" {
-			fmt.Printf("%s - %s\n", ent.name, ent.error)
-			error = true
-			continue
-		}
-		fmt.Printf("%s\n", ent.name)
+	if t.directories {
+		fmt.Printf("\n%s\n", dirs)
+	} else {
+		fmt.Printf("\n%s, %s\n", dirs, files)
 	}
 
-	if error {
+	if t.failed {
 		return 1
 	}
 	return 0
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Structure for our options and state.
+type uniqCommand struct {
+
+	// Prefix lines with the number of times they occurred?
+	count bool
+
+	// Show only lines which are repeated?
+	duplicates bool
+
+	// Show only lines which are not repeated?
+	uniques bool
+
+	// Ignore case when comparing?
+	ignoreCase bool
+
+	// Compare all lines, not just adjacent ones?
+	global bool
+}
+
+// uniqLine holds a line, and the number of times it was seen.
+type uniqLine struct {
+	text  string
+	count int
+}
+
+// Arguments adds per-command args to the object.
+func (u *uniqCommand) Arguments(f *flag.FlagSet) {
+	f.BoolVar(&u.count, "c", false, "Prefix lines with the number of times they occurred")
+	f.BoolVar(&u.duplicates, "d", false, "Show only lines which are repeated")
+	f.BoolVar(&u.uniques, "u", false, "Show only lines which are not repeated")
+	f.BoolVar(&u.ignoreCase, "i", false, "Ignore case when comparing lines")
+	f.BoolVar(&u.global, "global", false, "Remove all duplicate lines, not just adjacent ones")
+}
+
+// Info returns the name of this subcommand.
+func (u *uniqCommand) Info() (string, string) {
+	return "uniq", `Remove duplicate lines.
+
+Details:
+
+This command reads the named file, or STDIN if none is given, and
+collapses adjacent identical lines into a single line, in the same way
+as the standard 'uniq' command.
+
+The '-c' flag prefixes each line with the number of times it occurred,
+'-d' shows only lines which were repeated, and '-u' only those which
+were not.  The '-i' flag ignores case when comparing lines.
+
+The '-global' flag removes all duplicates, rather than just adjacent
+ones, so the input needn't be sorted first.  Lines are shown in the
+order in which they first appeared.
+
+Examples:
+
+$ sort access.log | sysbox uniq -c
+$ sysbox uniq -global -i names.txt`
+}
+
+// show outputs the given line, if it should be shown.
+func (u *uniqCommand) show(out *bufio.Writer, line uniqLine) {
+
+	if u.duplicates && line.count < 2 {
+		return
+	}
+	if u.uniques && line.count > 1 {
+		return
+	}
+
+	if u.count {
+		fmt.Fprintf(out, "%7d %s\n", line.count, line.text)
+	} else {
+		fmt.Fprintf(out, "%s\n", line.text)
+	}
+}
+
+// Execute is invoked if the user specifies `uniq` as the subcommand.
+func (u *uniqCommand) Execute(args []string) int {
+
+	if len(args) > 1 {
+		fmt.Printf("Usage: uniq [-c] [-d] [-u] [-i] [-global] [file]\n")
+		return 1
+	}
+
+	var in io.Reader = os.Stdin
+	if len(args) == 1 && args[0] != "-" {
+		file, err := os.Open(args[0])
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			return 1
+		}
+		defer file.Close()
+		in = file
+	}
+
+	key := func(line string) string {
+		if u.ignoreCase {
+			return strings.ToLower(line)
+		}
+		return line
+	}
+
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+
+	// Lines we've seen, in the order we first saw them.
+	var lines []uniqLine
+
+	// The index of each line, by key, when working globally.
+	seen := make(map[string]int)
+
+	reader := bufio.NewReader(in)
+	for {
+		text, err := reader.ReadString('\n')
+		if len(text) > 0 {
+			text = strings.TrimSuffix(text, "\n")
+			k := key(text)
+
+			if u.global {
+				if i, ok := seen[k]; ok {
+					lines[i].count++
+				} else {
+					seen[k] = len(lines)
+					lines = append(lines, uniqLine{text: text, count: 1})
+				}
+			} else if len(lines) > 0 && key(lines[0].text) == k {
+				lines[0].count++
+			} else {
+
+				// We only need to remember the previous line.
+				if len(lines) > 0 {
+					u.show(out, lines[0])
+				}
+				lines = []uniqLine{{text: text, count: 1}}
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			out.Flush()
+			fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+			return 1
+		}
+	}
+
+	for _, line := range lines {
+		u.show(out, line)
+	}
+
+	return 0
+}
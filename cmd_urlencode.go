@@ -0,0 +1,129 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// Structure for our options and state.
+type urlencodeCommand struct {
+
+	// Decode, rather than encode?
+	decode bool
+
+	// The type of escaping to perform.
+	mode string
+}
+
+// Arguments adds per-command args to the object.
+func (u *urlencodeCommand) Arguments(f *flag.FlagSet) {
+	f.BoolVar(&u.decode, "d", false, "Decode the input, rather than encoding it")
+	f.StringVar(&u.mode, "mode", "component", "The type of escaping to use: component, query, path, or url")
+}
+
+// Info returns the name of this subcommand.
+func (u *urlencodeCommand) Info() (string, string) {
+	return "urlencode", `Encode, or decode, URL components.
+
+Details:
+
+This command URL-encodes each of its arguments, or each line read from
+STDIN if there are none, and prints the result.  The '-d' flag will
+decode the input instead.
+
+The escaping performed depends upon the '-mode' flag:
+
+   component  Escape everything except letters, digits, and '-._~'.
+              This is the default, and is suitable for a single value.
+   query      Escape for use in a query-string, so spaces become '+'.
+   path       Escape for use as a path segment.
+   url        Escape a complete URL, leaving its structure intact.
+
+Examples:
+
+$ sysbox urlencode 'a b&c'
+a%20b%26c
+$ sysbox urlencode -d 'a%20b%26c'
+a b&c`
+}
+
+// escapeURL escapes the characters which may not appear in a URL, leaving
+// the reserved characters, which give it structure, untouched.
+func escapeURL(s string) string {
+
+	const allowed = "-_.~:/?#[]@!$&'()*+,;=%"
+
+	var out strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || strings.IndexByte(allowed, c) >= 0 {
+			out.WriteByte(c)
+		} else {
+			fmt.Fprintf(&out, "%%%02X", c)
+		}
+	}
+	return out.String()
+}
+
+// convert encodes, or decodes, the given input.
+func (u *urlencodeCommand) convert(input string) (string, error) {
+
+	if u.decode {
+		if u.mode == "query" {
+			return url.QueryUnescape(input)
+		}
+		return url.PathUnescape(input)
+	}
+
+	switch u.mode {
+	case "query":
+		return url.QueryEscape(input), nil
+	case "path":
+		return url.PathEscape(input), nil
+	case "url":
+		return escapeURL(input), nil
+	}
+
+	// Component escaping is query escaping, with spaces as "%20".
+	return strings.Replace(url.QueryEscape(input), "+", "%20", -1), nil
+}
+
+// Execute is invoked if the user specifies `urlencode` as the subcommand.
+func (u *urlencodeCommand) Execute(args []string) int {
+
+	switch u.mode {
+	case "component", "query", "path", "url":
+	default:
+		fmt.Printf("error: unknown mode '%s'\n", u.mode)
+		return 1
+	}
+
+	// Read from STDIN if we have no arguments.
+	if len(args) == 0 {
+		scanner := bufio.NewScanner(os.Stdin)
+		for scanner.Scan() {
+			args = append(args, scanner.Text())
+		}
+		if err := scanner.Err(); err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			return 1
+		}
+	}
+
+	ret := 0
+	for _, arg := range args {
+		out, err := u.convert(arg)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+			ret = 1
+			continue
+		}
+		fmt.Println(out)
+	}
+
+	return ret
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Structure for our options and state.
+type uuidCommand struct {
+
+	// The version of UUID to generate.
+	version int
+
+	// The number of UUIDs to generate.
+	count int
+
+	// Output in upper-case?
+	upper bool
+
+	// Omit the dashes?
+	noDashes bool
+
+	// The timestamp, and sequence, of the last time-based UUID we made,
+	// used to keep them unique, and ordered.
+	lastTime uint64
+	sequence uint16
+
+	// The random node-ID and clock-sequence for version 1 UUIDs.
+	node     []byte
+	clockSeq uint16
+}
+
+// Arguments adds per-command args to the object.
+func (u *uuidCommand) Arguments(f *flag.FlagSet) {
+	f.IntVar(&u.version, "v", 4, "The version of UUID to generate: 1, 4, or 7")
+	f.IntVar(&u.count, "n", 1, "The number of UUIDs to generate")
+	f.BoolVar(&u.upper, "upper", false, "Output the UUIDs in upper-case")
+	f.BoolVar(&u.noDashes, "no-dashes", false, "Omit the dashes from the output")
+}
+
+// Info returns the name of this subcommand.
+func (u *uuidCommand) Info() (string, string) {
+	return "uuid", `Generate UUIDs.
+
+Details:
+
+This command generates random (version 4) UUIDs by default, but '-v'
+may be used to choose time-based UUIDs, either version 1, or version 7,
+which sort in the order they were created.
+
+Many UUIDs may be generated at once via '-n', and the output may be
+changed to upper-case with '-upper', or the dashes removed with
+'-no-dashes'.
+
+Examples:
+
+$ sysbox uuid
+$ sysbox uuid -v 7 -n 10`
+}
+
+// random fills the given slice with random bytes.
+func (u *uuidCommand) random(b []byte) error {
+	_, err := rand.Read(b)
+	return err
+}
+
+// v4 generates a random UUID.
+func (u *uuidCommand) v4() ([]byte, error) {
+
+	id := make([]byte, 16)
+	if err := u.random(id); err != nil {
+		return nil, err
+	}
+
+	id[6] = (id[6] & 0x0f) | 0x40
+	id[8] = (id[8] & 0x3f) | 0x80
+	return id, nil
+}
+
+// v1 generates a UUID from the current time, and a random node-ID.
+func (u *uuidCommand) v1() ([]byte, error) {
+
+	// The node and clock-sequence are chosen once per run.
+	if u.node == nil {
+		seed := make([]byte, 8)
+		if err := u.random(seed); err != nil {
+			return nil, err
+		}
+
+		// Set the multicast bit, as this isn't a real MAC address.
+		u.node = seed[:6]
+		u.node[0] |= 0x01
+		u.clockSeq = binary.BigEndian.Uint16(seed[6:]) & 0x3fff
+	}
+
+	// 100ns intervals since the start of the Gregorian calendar.
+	const epoch = 122192928000000000
+	now := uint64(time.Now().UnixNano()/100) + epoch
+	if now <= u.lastTime {
+		now = u.lastTime + 1
+	}
+	u.lastTime = now
+
+	id := make([]byte, 16)
+	binary.BigEndian.PutUint32(id[0:], uint32(now))
+	binary.BigEndian.PutUint16(id[4:], uint16(now>>32))
+	binary.BigEndian.PutUint16(id[6:], uint16(now>>48)&0x0fff|0x1000)
+	binary.BigEndian.PutUint16(id[8:], u.clockSeq|0x8000)
+	copy(id[10:], u.node)
+	return id, nil
+}
+
+// v7 generates a UUID from the current Unix time in milliseconds, followed
+// by random data.
+func (u *uuidCommand) v7() ([]byte, error) {
+
+	id := make([]byte, 16)
+	if err := u.random(id); err != nil {
+		return nil, err
+	}
+
+	// Use a counter for UUIDs made within the same millisecond, so
+	// that they remain ordered.
+	now := uint64(time.Now().UnixNano() / int64(time.Millisecond))
+	if now <= u.lastTime {
+		now = u.lastTime
+		u.sequence++
+		if u.sequence > 0x0fff {
+			now++
+			u.sequence = 0
+		}
+	} else {
+		u.sequence = binary.BigEndian.Uint16(id[6:]) & 0x07ff
+	}
+	u.lastTime = now
+
+	binary.BigEndian.PutUint16(id[0:], uint16(now>>32))
+	binary.BigEndian.PutUint32(id[2:], uint32(now))
+	binary.BigEndian.PutUint16(id[6:], u.sequence|0x7000)
+	id[8] = (id[8] & 0x3f) | 0x80
+	return id, nil
+}
+
+// format returns the string form of the given UUID.
+func (u *uuidCommand) format(id []byte) string {
+
+	out := hex.EncodeToString(id)
+	if !u.noDashes {
+		out = out[0:8] + "-" + out[8:12] + "-" + out[12:16] + "-" + out[16:20] + "-" + out[20:]
+	}
+	if u.upper {
+		out = strings.ToUpper(out)
+	}
+	return out
+}
+
+// Execute is invoked if the user specifies `uuid` as the subcommand.
+func (u *uuidCommand) Execute(args []string) int {
+
+	var generate func() ([]byte, error)
+
+	switch u.version {
+	case 1:
+		generate = u.v1
+	case 4:
+		generate = u.v4
+	case 7:
+		generate = u.v7
+	default:
+		fmt.Printf("error: unsupported UUID version %d\n", u.version)
+		return 1
+	}
+
+	for i := 0; i < u.count; i++ {
+		id, err := generate()
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			return 1
+		}
+		fmt.Println(u.format(id))
+	}
+
+	return 0
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// Structure for our options and state.
+type watchCommand struct {
+
+	// The number of seconds between runs.
+	interval float64
+
+	// Highlight the differences between runs?
+	differences bool
+
+	// Exit when the output changes?
+	exitOnChange bool
+}
+
+// Arguments adds per-command args to the object.
+func (w *watchCommand) Arguments(f *flag.FlagSet) {
+	f.Float64Var(&w.interval, "n", 2, "The number of seconds to wait between runs")
+	f.BoolVar(&w.differences, "d", false, "Highlight the differences from the previous run")
+	f.BoolVar(&w.exitOnChange, "g", false, "Exit when the output of the command changes")
+}
+
+// Info returns the name of this subcommand.
+func (w *watchCommand) Info() (string, string) {
+	return "watch", `Run a command repeatedly, showing its output.
+
+Details:
+
+This command runs the given command every two seconds, or the interval
+given via '-n', clearing the screen and showing its latest output each
+time, beneath a header containing the command and the current time.
+
+The command is run via '/bin/sh -c', so it may contain pipes and other
+shell syntax, in which case it should be quoted.  Both STDOUT and STDERR
+are shown.
+
+The '-d' flag highlights the characters which changed since the previous
+run, and '-g' exits when the output changes.
+
+Examples:
+
+$ sysbox watch df -h
+$ sysbox watch -n 0.5 -d 'ls -l /tmp | head'
+$ sysbox watch -g cat /var/run/app.pid`
+}
+
+// highlight returns the output, with the characters which differ from the
+// previous output shown in reverse video.
+func (w *watchCommand) highlight(output string, previous string) string {
+
+	old := strings.Split(previous, "\n")
+
+	var out strings.Builder
+	for i, line := range strings.Split(output, "\n") {
+		if i > 0 {
+			out.WriteString("\n")
+		}
+
+		var prev []rune
+		if i < len(old) {
+			prev = []rune(old[i])
+		}
+
+		for j, r := range []rune(line) {
+			if j < len(prev) && prev[j] == r {
+				out.WriteRune(r)
+			} else {
+				out.WriteString("\033[7m" + string(r) + "\033[0m")
+			}
+		}
+	}
+	return out.String()
+}
+
+// Execute is invoked if the user specifies `watch` as the subcommand.
+func (w *watchCommand) Execute(args []string) int {
+
+	if len(args) < 1 {
+		fmt.Printf("Usage: watch [-n seconds] [-d] [-g] command [args]\n")
+		return 1
+	}
+	if w.interval < 0.1 {
+		fmt.Printf("error: the interval must be at least 0.1 seconds\n")
+		return 1
+	}
+
+	command := strings.Join(args, " ")
+	interval := time.Duration(w.interval * float64(time.Second))
+
+	hostname, _ := os.Hostname()
+
+	previous := ""
+	first := true
+
+	for {
+		output, err := exec.Command("/bin/sh", "-c", command).CombinedOutput()
+		if err != nil {
+			if _, ok := err.(*exec.ExitError); !ok {
+				fmt.Printf("error: %s\n", err.Error())
+				return 1
+			}
+		}
+
+		shown := string(output)
+		if w.differences && !first {
+			shown = w.highlight(shown, previous)
+		}
+
+		// Clear the screen, and show the header.
+		fmt.Print("\033[H\033[2J")
+		fmt.Printf("Every %gs: %s\t%s: %s\n\n", w.interval, command, hostname, time.Now().Format(time.ANSIC))
+		fmt.Print(shown)
+
+		if w.exitOnChange && !first && string(output) != previous {
+			return 0
+		}
+
+		previous = string(output)
+		first = false
+
+		time.Sleep(interval)
+	}
+}
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Structure for our options and state.
+type wcCommand struct {
+
+	// Count lines?
+	lines bool
+
+	// Count words?
+	words bool
+
+	// Count bytes?
+	bytes bool
+
+	// Count characters?
+	runes bool
+}
+
+// wcCounts holds the counts for a single input.
+type wcCounts struct {
+	lines int64
+	words int64
+	runes int64
+	bytes int64
+}
+
+// Arguments adds per-command args to the object.
+func (w *wcCommand) Arguments(f *flag.FlagSet) {
+	f.BoolVar(&w.lines, "l", false, "Show the number of lines")
+	f.BoolVar(&w.words, "w", false, "Show the number of words")
+	f.BoolVar(&w.bytes, "c", false, "Show the number of bytes")
+	f.BoolVar(&w.runes, "m", false, "Show the number of characters")
+}
+
+// Info returns the name of this subcommand.
+func (w *wcCommand) Info() (string, string) {
+	return "wc", `Count the lines, words, and bytes in files.
+
+Details:
+
+This command counts the lines, words, and bytes of each named file, or of
+STDIN if no files are given, in the same way as the coreutils 'wc' tool.
+When several files are given a line showing the totals is added.
+
+The counts to show may be chosen with the flags:
+
+   -l  The number of lines, i.e. newline characters.
+   -w  The number of words, separated by whitespace.
+   -m  The number of UTF-8 characters.
+   -c  The number of bytes.
+
+If no flags are given lines, words, and bytes are shown.  As with
+coreutils a final line without a trailing newline isn't included in the
+count of lines, though its words and characters are.
+
+Examples:
+
+$ sysbox wc -l /etc/passwd
+$ sysbox wc *.go
+$ echo "Hello, World" | sysbox wc -w`
+}
+
+// count returns the counts for everything read from the given reader.
+func (w *wcCommand) count(in io.Reader) (wcCounts, error) {
+
+	var counts wcCounts
+
+	// We count the bytes consumed by each rune, rather than the
+	// length of the token, as invalid UTF-8 sequences are returned
+	// as the (longer) replacement character.
+	split := func(data []byte, atEOF bool) (int, []byte, error) {
+		advance, token, err := bufio.ScanRunes(data, atEOF)
+		counts.bytes += int64(advance)
+		return advance, token, err
+	}
+
+	scanner := bufio.NewScanner(in)
+	scanner.Split(split)
+
+	inWord := false
+	for scanner.Scan() {
+		r, _ := utf8.DecodeRune(scanner.Bytes())
+		counts.runes++
+
+		if r == '\n' {
+			counts.lines++
+		}
+
+		if unicode.IsSpace(r) {
+			inWord = false
+		} else if !inWord {
+			inWord = true
+			counts.words++
+		}
+	}
+
+	return counts, scanner.Err()
+}
+
+// countFile returns the counts for the named file, with "-" meaning STDIN.
+func (w *wcCommand) countFile(path string) (wcCounts, error) {
+
+	if path == "-" {
+		return w.count(os.Stdin)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return wcCounts{}, err
+	}
+	defer file.Close()
+
+	return w.count(file)
+}
+
+// values returns the counts the user wishes to see, in the usual order.
+func (w *wcCommand) values(counts wcCounts) []int64 {
+
+	var values []int64
+	if w.lines {
+		values = append(values, counts.lines)
+	}
+	if w.words {
+		values = append(values, counts.words)
+	}
+	if w.runes {
+		values = append(values, counts.runes)
+	}
+	if w.bytes {
+		values = append(values, counts.bytes)
+	}
+	return values
+}
+
+// Execute is invoked if the user specifies `wc` as the subcommand.
+func (w *wcCommand) Execute(args []string) int {
+
+	// Default to lines, words, and bytes.
+	if !w.lines && !w.words && !w.bytes && !w.runes {
+		w.lines = true
+		w.words = true
+		w.bytes = true
+	}
+
+	// Default to reading STDIN.
+	names := args
+	if len(args) == 0 {
+		args = []string{"-"}
+		names = []string{""}
+	}
+
+	ret := 0
+
+	var results [][]int64
+	var shown []string
+	var total wcCounts
+
+	for i, path := range args {
+		counts, err := w.countFile(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+			ret = 1
+			continue
+		}
+
+		total.lines += counts.lines
+		total.words += counts.words
+		total.runes += counts.runes
+		total.bytes += counts.bytes
+
+		results = append(results, w.values(counts))
+		shown = append(shown, names[i])
+	}
+
+	if len(args) > 1 {
+		results = append(results, w.values(total))
+		shown = append(shown, "total")
+	}
+
+	// Align the columns, based upon the largest total.
+	width := 1
+	if totals := w.values(total); len(totals) > 1 {
+		for _, value := range totals {
+			if l := len(strconv.FormatInt(value, 10)); l > width {
+				width = l
+			}
+		}
+	}
+
+	for i, values := range results {
+		var columns []string
+		for _, value := range values {
+			columns = append(columns, fmt.Sprintf("%*d", width, value))
+		}
+		if shown[i] != "" {
+			columns = append(columns, shown[i])
+		}
+		fmt.Println(strings.Join(columns, " "))
+	}
+
+	return ret
+}
@@ -0,0 +1,326 @@
+package main
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Structure for our options and state.
+type weatherCommand struct {
+
+	// The units to use, "metric" or "imperial".
+	units string
+
+	// Show the raw JSON response?
+	json bool
+
+	// The number of days to forecast.
+	days int
+
+	// The maximum time each request may take.
+	timeout time.Duration
+
+	// How long responses are cached for, zero to disable the cache.
+	cache time.Duration
+}
+
+// weatherPlace is a location, as returned by the geocoding API.
+type weatherPlace struct {
+	Name      string  `json:"name"`
+	Admin1    string  `json:"admin1"`
+	Country   string  `json:"country"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// weatherForecast is the subset of the forecast API response we use.
+type weatherForecast struct {
+	CurrentUnits map[string]string `json:"current_units"`
+	Current      struct {
+		Temperature   float64 `json:"temperature_2m"`
+		Apparent      float64 `json:"apparent_temperature"`
+		Humidity      float64 `json:"relative_humidity_2m"`
+		Code          int     `json:"weather_code"`
+		WindSpeed     float64 `json:"wind_speed_10m"`
+		WindDirection float64 `json:"wind_direction_10m"`
+	} `json:"current"`
+	Daily struct {
+		Time          []string  `json:"time"`
+		Code          []int     `json:"weather_code"`
+		Max           []float64 `json:"temperature_2m_max"`
+		Min           []float64 `json:"temperature_2m_min"`
+		Precipitation []float64 `json:"precipitation_probability_max"`
+	} `json:"daily"`
+}
+
+var (
+	// weatherGeocodeURL is the API used to find places by name.
+	weatherGeocodeURL = "https://geocoding-api.open-meteo.com/v1/search"
+
+	// weatherForecastURL is the API used to fetch the weather.
+	weatherForecastURL = "https://api.open-meteo.com/v1/forecast"
+
+	// weatherCoordinates matches locations given as "lat,lon".
+	weatherCoordinates = regexp.MustCompile(`^\s*(-?[0-9.]+)\s*,\s*(-?[0-9.]+)\s*$`)
+)
+
+// weatherCodes describe the WMO weather codes the API returns.
+var weatherCodes = map[int]string{
+	0:  "Clear sky",
+	1:  "Mainly clear",
+	2:  "Partly cloudy",
+	3:  "Overcast",
+	45: "Fog",
+	48: "Freezing fog",
+	51: "Light drizzle",
+	53: "Drizzle",
+	55: "Heavy drizzle",
+	56: "Light freezing drizzle",
+	57: "Freezing drizzle",
+	61: "Light rain",
+	63: "Rain",
+	65: "Heavy rain",
+	66: "Light freezing rain",
+	67: "Freezing rain",
+	71: "Light snow",
+	73: "Snow",
+	75: "Heavy snow",
+	77: "Snow grains",
+	80: "Light showers",
+	81: "Showers",
+	82: "Violent showers",
+	85: "Snow showers",
+	86: "Heavy snow showers",
+	95: "Thunderstorm",
+	96: "Thunderstorm with hail",
+	99: "Thunderstorm with heavy hail",
+}
+
+// Arguments adds per-command args to the object.
+func (w *weatherCommand) Arguments(f *flag.FlagSet) {
+	f.StringVar(&w.units, "units", "metric", "The units to use, 'metric' or 'imperial'")
+	f.BoolVar(&w.json, "json", false, "Show the raw JSON response from the API")
+	f.IntVar(&w.days, "days", 3, "The number of days to forecast, from 1 to 16")
+	f.DurationVar(&w.timeout, "timeout", 30*time.Second, "The maximum time each request may take")
+	f.DurationVar(&w.cache, "cache", 10*time.Minute, "How long to cache responses for, 0 to disable caching")
+}
+
+// Info returns the name of this subcommand.
+func (w *weatherCommand) Info() (string, string) {
+	return "weather", `Show the weather for a location.
+
+Details:
+
+This command shows the current weather, and a short forecast, for the
+given location, which may be the name of a place or its coordinates as
+'latitude,longitude'.  The data comes from the free Open-Meteo API,
+https://open-meteo.com/, which needs no API key.
+
+Metric units are used by default, '-units imperial' shows temperatures
+in Fahrenheit and wind speeds in miles per hour instead.  The '-json'
+flag shows the raw response from the API.
+
+Responses are cached for ten minutes, or the period given via '-cache',
+so that repeated calls don't hammer the API.
+
+Examples:
+
+$ sysbox weather Helsinki
+$ sysbox weather -units imperial 'New York'
+$ sysbox weather -days 7 -json 51.5,-0.12`
+}
+
+// fetch fetches the given URL, via the cache.
+func (w *weatherCommand) fetch(address string) ([]byte, error) {
+
+	var path string
+	if w.cache > 0 {
+		if dir, err := os.UserCacheDir(); err == nil {
+			path = filepath.Join(dir, "sysbox", fmt.Sprintf("weather-%x.json", sha1.Sum([]byte(address))))
+
+			info, err := os.Stat(path)
+			if err == nil && time.Since(info.ModTime()) < w.cache {
+				if data, err := ioutil.ReadFile(path); err == nil {
+					return data, nil
+				}
+			}
+		}
+	}
+
+	// Reuse the plumbing of http-get, for its timeout and retries.
+	hg := &httpGetCommand{timeout: w.timeout, maxRedirects: 10, retry: 2, retryDelay: time.Second}
+	data, err := hg.body(address)
+	if err != nil {
+		return nil, err
+	}
+
+	// Failing to cache the response isn't fatal.
+	if path != "" {
+		if os.MkdirAll(filepath.Dir(path), 0755) == nil {
+			ioutil.WriteFile(path, data, 0644)
+		}
+	}
+
+	return data, nil
+}
+
+// locate finds the given location.
+func (w *weatherCommand) locate(location string) (weatherPlace, error) {
+
+	if m := weatherCoordinates.FindStringSubmatch(location); m != nil {
+		var place weatherPlace
+		fmt.Sscan(m[1], &place.Latitude)
+		fmt.Sscan(m[2], &place.Longitude)
+		if place.Latitude < -90 || place.Latitude > 90 || place.Longitude < -180 || place.Longitude > 180 {
+			return place, fmt.Errorf("invalid coordinates '%s'", location)
+		}
+		return place, nil
+	}
+
+	params := url.Values{}
+	params.Set("name", location)
+	params.Set("count", "1")
+	params.Set("language", "en")
+	params.Set("format", "json")
+
+	data, err := w.fetch(weatherGeocodeURL + "?" + params.Encode())
+	if err != nil {
+		return weatherPlace{}, err
+	}
+
+	var results struct {
+		Results []weatherPlace `json:"results"`
+	}
+	if err := json.Unmarshal(data, &results); err != nil {
+		return weatherPlace{}, fmt.Errorf("failed to parse the location: %s", err.Error())
+	}
+	if len(results.Results) == 0 {
+		return weatherPlace{}, fmt.Errorf("location '%s' not found", location)
+	}
+	return results.Results[0], nil
+}
+
+// describe returns the name of the given place.
+func (w *weatherCommand) describe(place weatherPlace) string {
+
+	var parts []string
+	for _, part := range []string{place.Name, place.Admin1, place.Country} {
+		if part != "" && (len(parts) == 0 || parts[len(parts)-1] != part) {
+			parts = append(parts, part)
+		}
+	}
+
+	coords := fmt.Sprintf("%.2f, %.2f", place.Latitude, place.Longitude)
+	if len(parts) == 0 {
+		return coords
+	}
+	return fmt.Sprintf("%s (%s)", strings.Join(parts, ", "), coords)
+}
+
+// conditions describes a WMO weather code.
+func (w *weatherCommand) conditions(code int) string {
+	if text, ok := weatherCodes[code]; ok {
+		return text
+	}
+	return fmt.Sprintf("Unknown (%d)", code)
+}
+
+// compass returns the compass point of a direction, in degrees.
+func (w *weatherCommand) compass(degrees float64) string {
+	points := []string{"N", "NE", "E", "SE", "S", "SW", "W", "NW"}
+	return points[int((degrees+22.5)/45)%8]
+}
+
+// Execute is invoked if the user specifies `weather` as the subcommand.
+func (w *weatherCommand) Execute(args []string) int {
+
+	if len(args) < 1 {
+		fmt.Printf("Usage: weather [-units metric|imperial] [-days n] [-json] location\n")
+		return 1
+	}
+	if w.units != "metric" && w.units != "imperial" {
+		fmt.Printf("error: -units must be 'metric' or 'imperial'\n")
+		return 1
+	}
+	if w.days < 1 || w.days > 16 {
+		fmt.Printf("error: -days must be between 1 and 16\n")
+		return 1
+	}
+
+	place, err := w.locate(strings.Join(args, " "))
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+
+	params := url.Values{}
+	params.Set("latitude", fmt.Sprintf("%.4f", place.Latitude))
+	params.Set("longitude", fmt.Sprintf("%.4f", place.Longitude))
+	params.Set("current", "temperature_2m,apparent_temperature,relative_humidity_2m,weather_code,wind_speed_10m,wind_direction_10m")
+	params.Set("daily", "weather_code,temperature_2m_max,temperature_2m_min,precipitation_probability_max")
+	params.Set("forecast_days", fmt.Sprintf("%d", w.days))
+	params.Set("timezone", "auto")
+	if w.units == "imperial" {
+		params.Set("temperature_unit", "fahrenheit")
+		params.Set("wind_speed_unit", "mph")
+		params.Set("precipitation_unit", "inch")
+	}
+
+	data, err := w.fetch(weatherForecastURL + "?" + params.Encode())
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+
+	if w.json {
+		fmt.Printf("%s\n", strings.TrimSpace(string(data)))
+		return 0
+	}
+
+	var forecast weatherForecast
+	if err := json.Unmarshal(data, &forecast); err != nil {
+		fmt.Printf("error: failed to parse the forecast: %s\n", err.Error())
+		return 1
+	}
+
+	temp := forecast.CurrentUnits["temperature_2m"]
+	speed := forecast.CurrentUnits["wind_speed_10m"]
+	now := forecast.Current
+
+	fmt.Printf("%s\n\n", w.describe(place))
+	fmt.Printf("Now:      %s, %.1f%s (feels like %.1f%s)\n", w.conditions(now.Code), now.Temperature, temp, now.Apparent, temp)
+	fmt.Printf("Humidity: %.0f%%\n", now.Humidity)
+	fmt.Printf("Wind:     %.1f %s from the %s\n", now.WindSpeed, speed, w.compass(now.WindDirection))
+
+	daily := forecast.Daily
+	if len(daily.Time) > 0 {
+		fmt.Printf("\nForecast:\n")
+	}
+	for i, day := range daily.Time {
+		if i >= len(daily.Code) || i >= len(daily.Max) || i >= len(daily.Min) {
+			break
+		}
+
+		label := day
+		if t, err := time.Parse("2006-01-02", day); err == nil {
+			label = t.Format("Mon 2006-01-02")
+		}
+
+		line := fmt.Sprintf("  %s  %-24s %3.0f%s to %3.0f%s", label, w.conditions(daily.Code[i]), daily.Min[i], temp, daily.Max[i], temp)
+		if i < len(daily.Precipitation) {
+			line += fmt.Sprintf(", %.0f%% chance of rain", daily.Precipitation[i])
+		}
+		fmt.Println(line)
+	}
+
+	return 0
+}
@@ -0,0 +1,192 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"strings"
+	"time"
+)
+
+// Structure for our options and state.
+type whoisCommand struct {
+
+	// The server to query, rather than finding one via IANA.
+	server string
+
+	// The time to wait for each server.
+	timeout time.Duration
+
+	// Strip comments and legal boilerplate from responses?
+	brief bool
+}
+
+// whoisReferrals are the keys servers use to refer us to another server.
+var whoisReferrals = []string{
+	"refer:",
+	"whois:",
+	"registrar whois server:",
+	"referralserver:",
+}
+
+// Arguments adds per-command args to the object.
+func (w *whoisCommand) Arguments(f *flag.FlagSet) {
+	f.StringVar(&w.server, "server", "", "The WHOIS server to query, rather than finding one via IANA")
+	f.DurationVar(&w.timeout, "timeout", 10*time.Second, "The time to wait for each server")
+	f.BoolVar(&w.brief, "brief", false, "Strip comments and legal boilerplate from the responses")
+}
+
+// Info returns the name of this subcommand.
+func (w *whoisCommand) Info() (string, string) {
+	return "whois", `Look up the registration of a domain, or IP address.
+
+Details:
+
+This command queries WHOIS servers, over TCP port 43, for the given
+domain or IP address, and shows their responses.
+
+By default the query starts at whois.iana.org, and any referrals to
+other servers are followed, for example from the registry responsible
+for a top-level domain to the registrar of the domain itself.  The
+'-server' flag starts the query at a different server instead, which
+may include a port, such as 'whois.example.com:4343'.
+
+The responses are not parsed, but the '-brief' flag strips comments and
+the legal notices most servers include.
+
+Examples:
+
+$ sysbox whois example.com
+$ sysbox whois -brief 8.8.8.8
+$ sysbox whois -server whois.ripe.net 193.0.6.139`
+}
+
+// query sends the query to the given server, and returns the response.
+func (w *whoisCommand) query(server string, query string) ([]byte, error) {
+
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(server, "43")
+	}
+
+	conn, err := net.DialTimeout("tcp", server, w.timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if w.timeout > 0 {
+		conn.SetDeadline(time.Now().Add(w.timeout))
+	}
+
+	if _, err := conn.Write([]byte(query + "\r\n")); err != nil {
+		return nil, err
+	}
+	return ioutil.ReadAll(conn)
+}
+
+// referral returns the server the response refers us to, if any.
+func (w *whoisCommand) referral(response []byte) string {
+
+	scanner := bufio.NewScanner(bytes.NewReader(response))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		for _, key := range whoisReferrals {
+			if len(line) <= len(key) || !strings.EqualFold(line[:len(key)], key) {
+				continue
+			}
+
+			server := strings.TrimSpace(line[len(key):])
+			server = strings.TrimPrefix(server, "whois://")
+			server = strings.TrimSuffix(server, "/")
+
+			// Referrals to other protocols, such as rwhois, are no use.
+			if server == "" || strings.Contains(server, "://") {
+				continue
+			}
+			return server
+		}
+	}
+	return ""
+}
+
+// trim strips comments and boilerplate from a response.
+func (w *whoisCommand) trim(response []byte) []byte {
+
+	var out bytes.Buffer
+	blank := true
+
+	scanner := bufio.NewScanner(bytes.NewReader(response))
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " \t\r")
+
+		if strings.HasPrefix(line, "%") || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if line == "" {
+			if !blank {
+				out.WriteString("\n")
+			}
+			blank = true
+			continue
+		}
+
+		out.WriteString(line + "\n")
+		blank = false
+
+		// ICANN registries and registrars end their records this way,
+		// with only the terms of use following.
+		if strings.HasPrefix(line, ">>> ") {
+			break
+		}
+	}
+
+	return bytes.TrimRight(out.Bytes(), "\n")
+}
+
+// Execute is invoked if the user specifies `whois` as the subcommand.
+func (w *whoisCommand) Execute(args []string) int {
+
+	if len(args) != 1 {
+		fmt.Printf("Usage: whois [-server host] [-timeout duration] [-brief] domain|ip\n")
+		return 1
+	}
+
+	query := args[0]
+	server := w.server
+	if server == "" {
+		server = "whois.iana.org"
+	}
+
+	// Servers we've already asked, so that we don't loop.
+	seen := make(map[string]bool)
+
+	for server != "" && !seen[strings.ToLower(server)] && len(seen) < 5 {
+		seen[strings.ToLower(server)] = true
+
+		response, err := w.query(server, query)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+			return 1
+		}
+
+		if len(seen) > 1 {
+			fmt.Printf("\n")
+		}
+		fmt.Printf("==> %s <==\n", server)
+
+		if w.brief {
+			fmt.Printf("%s\n", w.trim(response))
+		} else {
+			os.Stdout.Write(response)
+		}
+
+		server = w.referral(response)
+	}
+
+	return 0
+}
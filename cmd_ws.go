@@ -0,0 +1,247 @@
+package main
+
+import (
+	"bufio"
+	"crypto/tls"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"golang.org/x/net/websocket"
+)
+
+// Structure for our options and state.
+type wsCommand struct {
+
+	// Headers to add to the handshake, as "Name: Value".
+	headers stringList
+
+	// A single message to send, rather than reading STDIN.
+	message string
+
+	// The Origin to send, if not derived from the URL.
+	origin string
+
+	// The interval between pings, zero to disable them.
+	ping time.Duration
+
+	// The time to wait for messages once we've nothing more to send.
+	wait time.Duration
+
+	// The time to wait for the connection to be made.
+	timeout time.Duration
+
+	// Skip verification of TLS certificates?
+	insecure bool
+}
+
+// Arguments adds per-command args to the object.
+func (w *wsCommand) Arguments(f *flag.FlagSet) {
+	f.Var(&w.headers, "H", "Add a header to the handshake, as 'Name: Value'.  May be repeated.")
+	f.StringVar(&w.message, "m", "", "Send a single message, then exit, rather than reading messages from STDIN")
+	f.StringVar(&w.origin, "origin", "", "The Origin header to send, by default derived from the URL")
+	f.DurationVar(&w.ping, "ping", 30*time.Second, "The interval between pings, to keep the connection alive, 0 to disable them")
+	f.DurationVar(&w.wait, "wait", time.Second, "The time to wait for messages, once there are no more to send")
+	f.DurationVar(&w.timeout, "timeout", 30*time.Second, "The time to wait for the connection to be made")
+	f.BoolVar(&w.insecure, "insecure", false, "Skip verification of TLS certificates")
+}
+
+// Info returns the name of this subcommand.
+func (w *wsCommand) Info() (string, string) {
+	return "ws", `A simple WebSocket client.
+
+Details:
+
+This command connects to the given ws:// or wss:// URL, then sends each
+line read from STDIN as a message, and shows each message it receives
+upon STDOUT, in the manner of a WebSocket-aware netcat.
+
+The '-m' flag sends a single message instead, and exits once no more
+messages have been received for a second, or the period given via
+'-wait'.  The same wait happens once STDIN is closed.
+
+Headers may be added to the handshake via '-H', for authentication, and
+pings are sent every thirty seconds, or the interval given via '-ping',
+to keep the connection alive.
+
+Examples:
+
+$ sysbox ws wss://echo.example.com/
+$ sysbox ws -m '{"op":"subscribe"}' -wait 10s wss://stream.example.com/
+$ sysbox ws -H 'Authorization: Bearer TOKEN' wss://api.example.com/socket`
+}
+
+// config returns the configuration for connecting to the given URL.
+func (w *wsCommand) config(target string) (*websocket.Config, error) {
+
+	u, err := url.Parse(target)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "ws" && u.Scheme != "wss" {
+		return nil, fmt.Errorf("'%s' is not a ws, or wss, URL", target)
+	}
+
+	origin := w.origin
+	if origin == "" {
+		scheme := "http"
+		if u.Scheme == "wss" {
+			scheme = "https"
+		}
+		origin = scheme + "://" + u.Host
+	}
+
+	config, err := websocket.NewConfig(target, origin)
+	if err != nil {
+		return nil, err
+	}
+	config.Dialer = &net.Dialer{Timeout: w.timeout}
+	if w.insecure {
+		config.TlsConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+
+	for _, header := range w.headers {
+		parts := strings.SplitN(header, ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid header '%s', expected 'Name: Value'", header)
+		}
+		config.Header.Add(strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]))
+	}
+
+	return config, nil
+}
+
+// send sends a frame of the given type.
+func (w *wsCommand) send(ws *websocket.Conn, kind byte, data []byte) error {
+
+	ws.PayloadType = kind
+	_, err := ws.Write(data)
+	ws.PayloadType = websocket.TextFrame
+	return err
+}
+
+// Execute is invoked if the user specifies `ws` as the subcommand.
+func (w *wsCommand) Execute(args []string) int {
+
+	if len(args) != 1 {
+		fmt.Printf("Usage: ws [-H 'Name: Value'] [-m message] URL\n")
+		return 1
+	}
+
+	config, err := w.config(args[0])
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+
+	ws, err := websocket.DialConfig(config)
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		return 1
+	}
+	defer ws.Close()
+
+	// Read messages in the background.  Pings are answered for us.
+	messages := make(chan []byte)
+	failed := make(chan error, 1)
+	go func() {
+		for {
+			var msg []byte
+			if err := websocket.Message.Receive(ws, &msg); err != nil {
+				failed <- err
+				return
+			}
+			messages <- msg
+		}
+	}()
+
+	// Read the messages to send, unless we were given one.
+	lines := make(chan string)
+	if w.message != "" {
+		close(lines)
+		if err := w.send(ws, websocket.TextFrame, []byte(w.message)); err != nil {
+			fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+			return 1
+		}
+	} else {
+		go func() {
+			reader := bufio.NewReader(os.Stdin)
+			for {
+				line, err := reader.ReadString('\n')
+				if line != "" {
+					lines <- strings.TrimRight(line, "\r\n")
+				}
+				if err != nil {
+					close(lines)
+					return
+				}
+			}
+		}()
+	}
+
+	var pings <-chan time.Time
+	if w.ping > 0 {
+		ticker := time.NewTicker(w.ping)
+		defer ticker.Stop()
+		pings = ticker.C
+	}
+
+	// Catch Ctrl-C, so we can close the connection cleanly.
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	// The timer which ends the session, once there's nothing to send.
+	var finished <-chan time.Time
+
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+
+	for {
+		select {
+		case msg := <-messages:
+			out.Write(msg)
+			out.WriteString("\n")
+			out.Flush()
+
+			// Wait for more, once we've nothing to send.
+			if lines == nil {
+				finished = time.After(w.wait)
+			}
+		case err := <-failed:
+			if err == io.EOF {
+				return 0
+			}
+			out.Flush()
+			fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+			return 1
+		case line, ok := <-lines:
+			if !ok {
+				lines = nil
+				finished = time.After(w.wait)
+				continue
+			}
+			if err := w.send(ws, websocket.TextFrame, []byte(line)); err != nil {
+				out.Flush()
+				fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+				return 1
+			}
+		case <-pings:
+			if err := w.send(ws, websocket.PingFrame, nil); err != nil {
+				out.Flush()
+				fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+				return 1
+			}
+		case <-finished:
+			return 0
+		case <-interrupt:
+			return 0
+		}
+	}
+}
@@ -0,0 +1,381 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Structure for our options and state.
+type yaml2jsonCommand struct {
+
+	// Convert JSON to YAML, rather than YAML to JSON?
+	reverse bool
+
+	// Output minified JSON?
+	compact bool
+}
+
+// Arguments adds per-command args to the object.
+func (y *yaml2jsonCommand) Arguments(f *flag.FlagSet) {
+	f.BoolVar(&y.reverse, "r", false, "Convert JSON to YAML, rather than YAML to JSON")
+	f.BoolVar(&y.compact, "compact", false, "Output minified JSON")
+}
+
+// Info returns the name of this subcommand.
+func (y *yaml2jsonCommand) Info() (string, string) {
+	return "yaml2json", `Convert YAML to JSON, or JSON to YAML.
+
+Details:
+
+This command reads YAML from the named file, or STDIN if no file is
+given, and outputs the equivalent JSON.  The order of keys is preserved,
+and anchors, aliases, and merge-keys ('<<') are expanded.
+
+If the input contains multiple YAML documents the output will be a JSON
+array, containing one entry for each document.
+
+JSON is pretty-printed by default, but '-compact' will minify it.
+
+The '-r' flag reverses the conversion, reading JSON and writing YAML.
+
+Examples:
+
+$ sysbox yaml2json config.yaml | jq .
+$ curl -s https://example.com/data.json | sysbox yaml2json -r`
+}
+
+// yamlToJSON writes the JSON form of the given YAML node to the buffer.
+//
+// The depth is used to detect aliases which refer to themselves.
+func (y *yaml2jsonCommand) yamlToJSON(out *bytes.Buffer, node *yaml.Node, depth int) error {
+
+	if depth > 1000 {
+		return fmt.Errorf("line %d: aliases are nested too deeply", node.Line)
+	}
+
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			out.WriteString("null")
+			return nil
+		}
+		return y.yamlToJSON(out, node.Content[0], depth+1)
+
+	case yaml.AliasNode:
+		return y.yamlToJSON(out, node.Alias, depth+1)
+
+	case yaml.SequenceNode:
+		out.WriteString("[")
+		for i, child := range node.Content {
+			if i > 0 {
+				out.WriteString(",")
+			}
+			if err := y.yamlToJSON(out, child, depth+1); err != nil {
+				return err
+			}
+		}
+		out.WriteString("]")
+		return nil
+
+	case yaml.MappingNode:
+		keys, values, err := y.mappingEntries(node, depth)
+		if err != nil {
+			return err
+		}
+
+		out.WriteString("{")
+		for i, key := range keys {
+			if i > 0 {
+				out.WriteString(",")
+			}
+			name, _ := json.Marshal(key)
+			out.Write(name)
+			out.WriteString(":")
+			if err := y.yamlToJSON(out, values[key], depth+1); err != nil {
+				return err
+			}
+		}
+		out.WriteString("}")
+		return nil
+
+	case yaml.ScalarNode:
+		return y.scalarToJSON(out, node)
+	}
+
+	return fmt.Errorf("line %d: unknown YAML node", node.Line)
+}
+
+// mappingEntries returns the keys, in order, and values of the given
+// mapping node, expanding any merge-keys it contains.
+func (y *yaml2jsonCommand) mappingEntries(node *yaml.Node, depth int) ([]string, map[string]*yaml.Node, error) {
+
+	var keys []string
+	values := make(map[string]*yaml.Node)
+
+	// Explicit keys always take precedence over merged ones.
+	explicit := make(map[string]bool)
+
+	add := func(key string, value *yaml.Node, merged bool) {
+		if _, ok := values[key]; !ok {
+			keys = append(keys, key)
+		}
+		if merged && explicit[key] {
+			return
+		}
+		if !merged {
+			explicit[key] = true
+		}
+		values[key] = value
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key := node.Content[i]
+		value := node.Content[i+1]
+
+		if key.Kind == yaml.ScalarNode && key.Tag == "!!merge" {
+
+			// The value is a mapping, or a list of them.
+			sources := []*yaml.Node{value}
+			if resolveAlias(value).Kind == yaml.SequenceNode {
+				sources = resolveAlias(value).Content
+			}
+
+			for _, source := range sources {
+				source = resolveAlias(source)
+				if source.Kind != yaml.MappingNode {
+					return nil, nil, fmt.Errorf("line %d: merge-key requires a mapping", key.Line)
+				}
+				mergedKeys, mergedValues, err := y.mappingEntries(source, depth+1)
+				if err != nil {
+					return nil, nil, err
+				}
+				for _, k := range mergedKeys {
+					add(k, mergedValues[k], true)
+				}
+			}
+			continue
+		}
+
+		key = resolveAlias(key)
+		if key.Kind != yaml.ScalarNode {
+			return nil, nil, fmt.Errorf("line %d: only scalar keys may be converted to JSON", key.Line)
+		}
+		add(key.Value, value, false)
+	}
+
+	return keys, values, nil
+}
+
+// resolveAlias returns the node an alias refers to, or the node itself.
+func resolveAlias(node *yaml.Node) *yaml.Node {
+	for node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	return node
+}
+
+// scalarToJSON writes the JSON form of the given scalar to the buffer.
+func (y *yaml2jsonCommand) scalarToJSON(out *bytes.Buffer, node *yaml.Node) error {
+
+	switch node.ShortTag() {
+	case "!!null":
+		out.WriteString("null")
+		return nil
+
+	case "!!bool", "!!int", "!!float":
+		var value interface{}
+		if err := node.Decode(&value); err != nil {
+			return err
+		}
+
+		// JSON has no representation of infinity, or NaN.
+		if f, ok := value.(float64); ok && (math.IsInf(f, 0) || math.IsNaN(f)) {
+			return fmt.Errorf("line %d: %s cannot be represented in JSON", node.Line, node.Value)
+		}
+
+		data, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		out.Write(data)
+		return nil
+	}
+
+	// Everything else, including timestamps, is a string.
+	data, _ := json.Marshal(node.Value)
+	out.Write(data)
+	return nil
+}
+
+// toJSON converts the YAML stream in the input into JSON.
+func (y *yaml2jsonCommand) toJSON(in io.Reader, out io.Writer) error {
+
+	var docs []*bytes.Buffer
+
+	decoder := yaml.NewDecoder(in)
+	for {
+		var node yaml.Node
+		err := decoder.Decode(&node)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		buf := &bytes.Buffer{}
+		if err := y.yamlToJSON(buf, &node, 0); err != nil {
+			return err
+		}
+		docs = append(docs, buf)
+	}
+
+	// Multiple documents become an array.
+	var result bytes.Buffer
+	switch len(docs) {
+	case 0:
+		result.WriteString("null")
+	case 1:
+		result.Write(docs[0].Bytes())
+	default:
+		result.WriteString("[")
+		for i, doc := range docs {
+			if i > 0 {
+				result.WriteString(",")
+			}
+			result.Write(doc.Bytes())
+		}
+		result.WriteString("]")
+	}
+
+	if !y.compact {
+		var pretty bytes.Buffer
+		if err := json.Indent(&pretty, result.Bytes(), "", "  "); err != nil {
+			return err
+		}
+		result = pretty
+	}
+
+	result.WriteString("\n")
+	_, err := out.Write(result.Bytes())
+	return err
+}
+
+// jsonToYAML reads a single JSON value from the decoder, returning it as
+// a YAML node.  Nodes are used so that the order of keys is preserved.
+func (y *yaml2jsonCommand) jsonToYAML(dec *json.Decoder) (*yaml.Node, error) {
+
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch t := tok.(type) {
+	case json.Delim:
+		node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		if t == '{' {
+			node = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		}
+
+		for dec.More() {
+			if node.Kind == yaml.MappingNode {
+				key, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key.(string)})
+			}
+
+			child, err := y.jsonToYAML(dec)
+			if err != nil {
+				return nil, err
+			}
+			node.Content = append(node.Content, child)
+		}
+
+		// Consume the closing delimiter.
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return node, nil
+
+	case string:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: t}, nil
+	case json.Number:
+		tag := "!!float"
+		if _, err := strconv.ParseInt(t.String(), 10, 64); err == nil {
+			tag = "!!int"
+		}
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: t.String()}, nil
+	case bool:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: strconv.FormatBool(t)}, nil
+	}
+
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}, nil
+}
+
+// toYAML converts the JSON values in the input into YAML documents.
+func (y *yaml2jsonCommand) toYAML(in io.Reader, out io.Writer) error {
+
+	dec := json.NewDecoder(in)
+	dec.UseNumber()
+
+	encoder := yaml.NewEncoder(out)
+	encoder.SetIndent(2)
+
+	for {
+		node, err := y.jsonToYAML(dec)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := encoder.Encode(node); err != nil {
+			return err
+		}
+	}
+
+	return encoder.Close()
+}
+
+// Execute is invoked if the user specifies `yaml2json` as the subcommand.
+func (y *yaml2jsonCommand) Execute(args []string) int {
+
+	if len(args) > 1 {
+		fmt.Printf("Usage: yaml2json [-r] [file]\n")
+		return 1
+	}
+
+	var in io.Reader = os.Stdin
+	if len(args) == 1 && args[0] != "-" {
+		file, err := os.Open(args[0])
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			return 1
+		}
+		defer file.Close()
+		in = file
+	}
+
+	var err error
+	if y.reverse {
+		err = y.toYAML(in, os.Stdout)
+	} else {
+		err = y.toJSON(in, os.Stdout)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+		return 1
+	}
+	return 0
+}
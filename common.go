@@ -28,3 +28,18 @@ func FindFiles(path string, suffixes []string) ([]string, error) {
 
 	return results, err
 }
+
+// stringList is a flag.Value which may be specified multiple times,
+// collecting each of the values given.
+type stringList []string
+
+// String returns the values we've collected.
+func (s *stringList) String() string {
+	return strings.Join(*s, ", ")
+}
+
+// Set appends the given value to our list.
+func (s *stringList) Set(value string) error {
+	*s = append(*s, value)
+	return nil
+}
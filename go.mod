@@ -5,6 +5,7 @@ go 1.14
 require (
 	github.com/anacrolix/log v0.7.0
 	github.com/anacrolix/torrent v1.15.2
+	github.com/chzyer/readline v1.5.1
 	github.com/creack/pty v1.1.7
 	github.com/dustin/go-humanize v1.0.0
 	github.com/hashicorp/memberlist v0.2.0
@@ -12,5 +13,7 @@ require (
 	github.com/nightlyone/lockfile v1.0.0
 	github.com/skx/subcommands v0.6.0
 	golang.org/x/crypto v0.0.0-20190923035154-9ee001bba392
+	golang.org/x/net v0.0.0-20200114155413-6afb5195e5aa
 	gopkg.in/yaml.v2 v2.2.8
+	gopkg.in/yaml.v3 v3.0.1
 )
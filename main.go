@@ -14,28 +14,87 @@ func main() {
 	//
 	// Register each of our subcommands.
 	//
+	subcommands.Register(&bannerCommand{})
+	subcommands.Register(&baseCommand{})
+	subcommands.Register(&base64Command{})
+	subcommands.Register(&bytesCommand{})
 	subcommands.Register(&calcCommand{})
+	subcommands.Register(&certCommand{})
 	subcommands.Register(&chronicCommand{})
 	subcommands.Register(&collapseCommand{})
+	subcommands.Register(&colorCommand{})
+	subcommands.Register(&cowsayCommand{})
+	subcommands.Register(&cronCommand{})
+	subcommands.Register(&csv2jsonCommand{})
+	subcommands.Register(&cutCommand{})
+	subcommands.Register(&datediffCommand{})
+	subcommands.Register(&dnsCommand{})
+	subcommands.Register(&dos2unixCommand{})
+	subcommands.Register(&duCommand{})
 	subcommands.Register(&envTemplateCommand{})
+	subcommands.Register(&envsubstCommand{})
+	subcommands.Register(&epochCommand{})
 	subcommands.Register(&execSTDINCommand{})
+	subcommands.Register(&filetypeCommand{})
+	subcommands.Register(&findCommand{})
 	subcommands.Register(&fingerdCommand{})
+	subcommands.Register(&gencertCommand{})
+	subcommands.Register(&genpasswdCommand{})
+	subcommands.Register(&grepCommand{})
+	subcommands.Register(&gzipCommand{})
+	subcommands.Register(&hashCommand{})
+	subcommands.Register(&headCommand{})
+	subcommands.Register(&hexdumpCommand{})
 	subcommands.Register(&httpdCommand{})
 	subcommands.Register(&httpGetCommand{})
 	subcommands.Register(&installCommand{})
+	subcommands.Register(&ipcalcCommand{})
+	subcommands.Register(&ipinfoCommand{})
 	subcommands.Register(&ipsCommand{})
+	subcommands.Register(&jsonCommand{})
+	subcommands.Register(&jwtCommand{})
+	subcommands.Register(&morseCommand{})
 	subcommands.Register(&passwordCommand{})
+	subcommands.Register(&pasteCommand{})
 	subcommands.Register(&peerdCommand{})
+	subcommands.Register(&pingCommand{})
+	subcommands.Register(&portCheckCommand{})
+	subcommands.Register(&qrCommand{})
+	subcommands.Register(&repeatCommand{})
+	subcommands.Register(&rollCommand{})
+	subcommands.Register(&romanCommand{})
+	subcommands.Register(&rot13Command{})
 	subcommands.Register(&runDirectoryCommand{})
+	subcommands.Register(&seqCommand{})
+	subcommands.Register(&serveCommand{})
+	subcommands.Register(&shortenCommand{})
+	subcommands.Register(&slugCommand{})
+	subcommands.Register(&sortCommand{})
 	subcommands.Register(&splayCommand{})
 	subcommands.Register(&SSLExpiryCommand{})
+	subcommands.Register(&stringsCommand{})
+	subcommands.Register(&tailCommand{})
+	subcommands.Register(&tarCommand{})
+	subcommands.Register(&templateCommand{})
 	subcommands.Register(&timeoutCommand{})
+	subcommands.Register(&timerCommand{})
 	subcommands.Register(&torrentCommand{})
+	subcommands.Register(&totpCommand{})
+	subcommands.Register(&trCommand{})
 	subcommands.Register(&treeCommand{})
+	subcommands.Register(&uniqCommand{})
+	subcommands.Register(&urlencodeCommand{})
 	subcommands.Register(&urlsCommand{})
+	subcommands.Register(&uuidCommand{})
 	subcommands.Register(&validateJSONCommand{})
 	subcommands.Register(&validateYAMLCommand{})
+	subcommands.Register(&watchCommand{})
+	subcommands.Register(&wcCommand{})
+	subcommands.Register(&weatherCommand{})
+	subcommands.Register(&whoisCommand{})
 	subcommands.Register(&withLockCommand{})
+	subcommands.Register(&wsCommand{})
+	subcommands.Register(&yaml2jsonCommand{})
 
 	//
 	// Execute the one the user chose.